@@ -0,0 +1,1196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// Validation functions are not called for TypeList or TypeSet attributes, so checks
+// that need to compare sibling values must run at plan time from a resource's CustomizeDiff, e.g.
+//
+//	CustomizeDiff: customdiff.Sequence(
+//		verify.SetTagsDiff,
+//		verify.WAFRulePrioritiesUnique("rule"),
+//	),
+//
+// CustomizeDiff cannot return warning diagnostics, so checks for configurations that are
+// likely, but not certainly, mistakes log a warning and never reject the configuration.
+
+// WAFRulePrioritiesUnique returns a CustomizeDiffFunc that tests that the "priority"
+// values of the WAF rule configuration blocks at the specified key are unique.
+// Conflicting rules are identified by their "name" values.
+func WAFRulePrioritiesUnique(key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+
+		return wafRulePrioritiesUnique(key, configurationBlocks(diff.Get(key)))
+	}
+}
+
+func wafRulePrioritiesUnique(key string, rules []map[string]interface{}) error {
+	names := make(map[int][]string)
+
+	for _, rule := range rules {
+		priority, ok := rule["priority"].(int)
+		if !ok {
+			continue
+		}
+		name, _ := rule["name"].(string)
+		names[priority] = append(names[priority], name)
+	}
+
+	priorities := make([]int, 0, len(names))
+	for priority := range names {
+		priorities = append(priorities, priority)
+	}
+	sort.Ints(priorities)
+
+	var errs []error
+
+	for _, priority := range priorities {
+		if v := names[priority]; len(v) > 1 {
+			sort.Strings(v)
+			errs = append(errs, fmt.Errorf("%q: rules (%s) have the same priority (%d); priorities must be unique", key, strings.Join(v, ", "), priority))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// configurationBlocks returns the non-nil configuration blocks from a TypeList or TypeSet value.
+func configurationBlocks(v interface{}) []map[string]interface{} {
+	l, _ := listValue(v)
+	blocks := make([]map[string]interface{}, 0, len(l))
+
+	for _, v := range l {
+		if v, ok := v.(map[string]interface{}); ok && v != nil {
+			blocks = append(blocks, v)
+		}
+	}
+
+	return blocks
+}
+
+// rawConfigAttribute returns the configured value of the attribute at the specified key, e.g. "desired_capacity"
+// or "rule.0.priority". Unlike the planned value, it is null if the attribute is not set in the configuration,
+// even if it is Computed or has a value in the prior state. Set elements cannot be addressed by index, so
+// keys into a TypeSet return an unknown value.
+func rawConfigAttribute(diff *schema.ResourceDiff, key string) cty.Value {
+	v := diff.GetRawConfig()
+
+	for _, step := range strings.Split(key, ".") {
+		if !v.IsKnown() {
+			return cty.DynamicVal
+		}
+		if v.IsNull() {
+			return cty.NullVal(cty.DynamicPseudoType)
+		}
+
+		switch ty := v.Type(); {
+		case ty.IsObjectType():
+			if !ty.HasAttribute(step) {
+				return cty.NullVal(cty.DynamicPseudoType)
+			}
+			v = v.GetAttr(step)
+		case ty.IsListType():
+			i, err := strconv.Atoi(step)
+			if err != nil || i < 0 || i >= v.LengthInt() {
+				return cty.NullVal(cty.DynamicPseudoType)
+			}
+			v = v.Index(cty.NumberIntVal(int64(i)))
+		default:
+			return cty.DynamicVal
+		}
+	}
+
+	return v
+}
+
+// ValidateListDiff returns a CustomizeDiffFunc which runs a list-level validation function
+// against the planned value of the TypeList or TypeSet attribute at the specified key.
+// The SDK does not run ValidateFunc or ValidateDiagFunc on TypeList or TypeSet attributes,
+// so list-level validators such as ListLenBetween must be run at plan time instead.
+// The check is skipped if the value is not yet known.
+func ValidateListDiff(key string, f schema.SchemaValidateDiagFunc) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+
+		l, _ := listValue(diff.Get(key))
+
+		return sdkdiag.DiagnosticsError(f(l, cty.GetAttrPath(key)))
+	}
+}
+
+// PrivateIPCountMatchesList is a CustomizeDiffFunc that tests that, when both "private_ip_list"
+// and "private_ips_count" are configured, the number of secondary private IP addresses in the list
+// (all but the first, primary, address) equals the count.
+// Resources that declare the two attributes as ConflictsWith do not need this check.
+func PrivateIPCountMatchesList(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("private_ip_list") || !diff.NewValueKnown("private_ips_count") {
+		return nil
+	}
+
+	v, ok := diff.GetOk("private_ip_list")
+	if !ok {
+		return nil
+	}
+	ips := v.([]interface{})
+
+	count, ok := diff.GetOk("private_ips_count")
+	if !ok {
+		return nil
+	}
+
+	if secondary := len(ips) - 1; secondary != count.(int) {
+		return fmt.Errorf("'private_ips_count' (%d) must equal the number of secondary private IP addresses in 'private_ip_list' (%d)", count.(int), secondary)
+	}
+
+	return nil
+}
+
+var route53RoutingPolicyKeys = []string{
+	"failover_routing_policy",
+	"geolocation_routing_policy",
+	"latency_routing_policy",
+	"weighted_routing_policy",
+}
+
+// Route53RoutingPolicyConsistent is a CustomizeDiffFunc that tests that at most one Route 53
+// routing policy configuration block is set and that "set_identifier" is set if and only if
+// a routing policy is configured.
+func Route53RoutingPolicyConsistent(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	var policies []string
+
+	for _, key := range route53RoutingPolicyKeys {
+		if v, ok := diff.GetOk(key); ok && len(v.([]interface{})) > 0 {
+			policies = append(policies, key)
+		}
+	}
+
+	setIdentifier, hasSetIdentifier := diff.GetOk("set_identifier")
+
+	switch {
+	case len(policies) > 1:
+		return fmt.Errorf("only one routing policy can be configured, got %s", strings.Join(policies, ", "))
+	case len(policies) == 1 && !hasSetIdentifier:
+		if !diff.NewValueKnown("set_identifier") {
+			return nil
+		}
+		return fmt.Errorf("'set_identifier' must be set when '%s' is configured", policies[0])
+	case len(policies) == 0 && hasSetIdentifier:
+		return fmt.Errorf("'set_identifier' (%s) must not be set without a routing policy (%s)", setIdentifier, strings.Join(route53RoutingPolicyKeys, ", "))
+	}
+
+	return nil
+}
+
+// EFSProvisionedThroughputConsistency is a CustomizeDiffFunc that tests that
+// "provisioned_throughput_in_mibps" is set if and only if "throughput_mode" is "provisioned".
+func EFSProvisionedThroughputConsistency(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("throughput_mode") || !diff.NewValueKnown("provisioned_throughput_in_mibps") {
+		return nil
+	}
+
+	mode := diff.Get("throughput_mode").(string)
+	_, hasThroughput := diff.GetOk("provisioned_throughput_in_mibps")
+
+	if mode == "provisioned" && !hasThroughput {
+		return fmt.Errorf("'provisioned_throughput_in_mibps' must be set when 'throughput_mode' is '%s'", mode)
+	}
+
+	if mode != "provisioned" && hasThroughput {
+		return fmt.Errorf("'provisioned_throughput_in_mibps' must not be set when 'throughput_mode' is '%s'", mode)
+	}
+
+	return nil
+}
+
+// fargateCPUMemory maps each Fargate task CPU value (CPU units) to its supported memory values (MiB).
+// See https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task_definition_parameters.html#task_size.
+var fargateCPUMemory = map[int][]int{
+	256:   {512, 1024, 2048},
+	512:   intRange(1024, 4096, 1024),
+	1024:  intRange(2048, 8192, 1024),
+	2048:  intRange(4096, 16384, 1024),
+	4096:  intRange(8192, 30720, 1024),
+	8192:  intRange(16384, 61440, 4096),
+	16384: intRange(32768, 122880, 8192),
+}
+
+func intRange(start, end, step int) []int {
+	var values []int
+
+	for v := start; v <= end; v += step {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// ValidFargateCPUMemory is a CustomizeDiffFunc that tests that the configured task "cpu" and "memory"
+// values are a supported Fargate combination.
+func ValidFargateCPUMemory(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("cpu") || !diff.NewValueKnown("memory") {
+		return nil
+	}
+
+	cpuValue, memoryValue := diff.Get("cpu").(string), diff.Get("memory").(string)
+	if cpuValue == "" || memoryValue == "" {
+		return nil
+	}
+
+	cpus := maps.Keys(fargateCPUMemory)
+	slices.Sort(cpus)
+
+	cpu, err := strconv.Atoi(cpuValue)
+	if err != nil {
+		return fmt.Errorf("'cpu' (%s) is not a supported Fargate CPU value, expected one of %v", cpuValue, cpus)
+	}
+
+	memories, ok := fargateCPUMemory[cpu]
+	if !ok {
+		return fmt.Errorf("'cpu' (%s) is not a supported Fargate CPU value, expected one of %v", cpuValue, cpus)
+	}
+
+	if memory, err := strconv.Atoi(memoryValue); err != nil || !slices.Contains(memories, memory) {
+		return fmt.Errorf("'memory' (%s) is not supported with 'cpu' (%s) on Fargate, expected one of %v", memoryValue, cpuValue, memories)
+	}
+
+	return nil
+}
+
+// BucketPolicyReferencesBucket returns a CustomizeDiffFunc that logs a warning if no statement in
+// the S3 bucket policy in the "policy" attribute has a Resource referencing the bucket named by the
+// attribute at bucketKey, e.g. "arn:aws:s3:::bucket" or "arn:aws:s3:::bucket/*".
+// Advanced policies may legitimately reference only other resources.
+func BucketPolicyReferencesBucket(bucketKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(bucketKey) || !diff.NewValueKnown("policy") {
+			return nil
+		}
+
+		bucket, policy := diff.Get(bucketKey).(string), diff.Get("policy").(string)
+		if bucket == "" || policy == "" {
+			return nil
+		}
+
+		if ok, err := bucketPolicyReferencesBucket(policy, bucket); err == nil && !ok {
+			log.Printf("[WARN] S3 Bucket (%s) policy has no statement with a Resource referencing the bucket (arn:*:s3:::%[1]s or arn:*:s3:::%[1]s/*)", bucket)
+		}
+
+		return nil
+	}
+}
+
+// bucketPolicyReferencesBucket returns whether any statement in the policy has a Resource referencing the bucket.
+func bucketPolicyReferencesBucket(policy, bucket string) (bool, error) {
+	statements, err := iamPolicyStatements(policy)
+	if err != nil {
+		return false, err
+	}
+
+	prefix := ":s3:::" + bucket
+
+	for _, statement := range statements {
+		resources, _ := iamPolicyStringValues(statement["Resource"])
+
+		for _, resource := range resources {
+			if !strings.HasPrefix(resource, "arn:") {
+				continue
+			}
+
+			if _, after, ok := strings.Cut(resource, prefix); ok && (after == "" || strings.HasPrefix(after, "/")) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// snsFIFOSubscriptionProtocols are the subscription protocols supported by SNS FIFO topics.
+var snsFIFOSubscriptionProtocols = []string{
+	"sqs",
+}
+
+// SNSFIFOConsistency is a CustomizeDiffFunc that tests SNS FIFO topic settings for consistency.
+// For topics ("name", "fifo_topic", "content_based_deduplication") it tests that FIFO topic names
+// end with ".fifo" and that content-based deduplication is only enabled for FIFO topics.
+// For topic subscriptions ("topic_arn", "protocol") it tests that subscriptions to FIFO topics
+// use a FIFO-compatible protocol.
+func SNSFIFOConsistency(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	name, _ := diff.Get("name").(string)
+	fifoTopic, _ := diff.Get("fifo_topic").(bool)
+	fifoName := strings.HasSuffix(name, ".fifo")
+
+	if fifoTopic && name != "" && !fifoName && diff.NewValueKnown("name") {
+		return fmt.Errorf("'name' (%s) must end with '.fifo' when 'fifo_topic' is true", name)
+	}
+
+	if v, ok := diff.Get("content_based_deduplication").(bool); ok && v && !fifoTopic && !fifoName {
+		return fmt.Errorf("'content_based_deduplication' can only be set for FIFO topics")
+	}
+
+	if !diff.NewValueKnown("topic_arn") || !diff.NewValueKnown("protocol") {
+		return nil
+	}
+
+	topicARN, _ := diff.Get("topic_arn").(string)
+	protocol, _ := diff.Get("protocol").(string)
+
+	if strings.HasSuffix(topicARN, ".fifo") && protocol != "" && !slices.Contains(snsFIFOSubscriptionProtocols, protocol) {
+		return fmt.Errorf("'protocol' (%s) is not supported for subscriptions to FIFO topic (%s), expected one of %q", protocol, topicARN, snsFIFOSubscriptionProtocols)
+	}
+
+	return nil
+}
+
+// IntMatchesListLength returns a CustomizeDiffFunc that tests that, when both are configured,
+// the integer attribute at countKey equals the number of elements in the TypeList or TypeSet
+// attribute at listKey. The check is skipped if either value is not yet known.
+func IntMatchesListLength(countKey, listKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(countKey) || !diff.NewValueKnown(listKey) {
+			return nil
+		}
+
+		count, ok := diff.GetOk(countKey)
+		if !ok {
+			return nil
+		}
+
+		v, ok := diff.GetOk(listKey)
+		if !ok {
+			return nil
+		}
+		l, _ := listValue(v)
+
+		if n := len(l); n != count.(int) {
+			return fmt.Errorf("'%s' (%d) must equal the number of elements in '%s' (%d)", countKey, count.(int), listKey, n)
+		}
+
+		return nil
+	}
+}
+
+// S3LifecycleTransitionsConsistent returns a CustomizeDiffFunc that tests that the "transition"
+// configuration blocks of each S3 lifecycle rule at the specified key specify at most one of
+// "days" and "date", and that the "days" values increase from one transition to the next.
+// Transitions in a TypeSet have no configured order, so their "days" values need only be distinct.
+// Rules are identified by their "id" values.
+func S3LifecycleTransitionsConsistent(key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+
+		return s3LifecycleTransitionsConsistent(key, configurationBlocks(diff.Get(key)))
+	}
+}
+
+func s3LifecycleTransitionsConsistent(key string, rules []map[string]interface{}) error {
+	var errs []error
+
+	for _, rule := range rules {
+		id, _ := rule["id"].(string)
+		transitions := configurationBlocks(rule["transition"])
+
+		var previousDays int
+		var previousStorageClass string
+		hasPrevious := false
+		if _, ok := rule["transition"].(*schema.Set); ok {
+			sort.SliceStable(transitions, func(i, j int) bool {
+				di, _ := transitions[i]["days"].(int)
+				dj, _ := transitions[j]["days"].(int)
+				return di < dj
+			})
+		}
+
+		for _, transition := range transitions {
+			days, _ := transition["days"].(int)
+			date, _ := transition["date"].(string)
+			storageClass, _ := transition["storage_class"].(string)
+
+			if date != "" {
+				if days != 0 {
+					errs = append(errs, fmt.Errorf("%q: rule (%s): transition (%s) cannot specify both days (%d) and date (%s)", key, id, storageClass, days, date))
+				}
+				continue
+			}
+
+			if hasPrevious && days <= previousDays {
+				errs = append(errs, fmt.Errorf("%q: rule (%s): transition (%s) days (%d) must be greater than transition (%s) days (%d)", key, id, storageClass, days, previousStorageClass, previousDays))
+			}
+			previousDays, previousStorageClass, hasPrevious = days, storageClass, true
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ASGCapacityOrdered is a CustomizeDiffFunc that tests that an Auto Scaling group's
+// "min_size", "desired_capacity" and "max_size" values satisfy min_size <= desired_capacity <= max_size.
+// The check is skipped if "desired_capacity" is not configured, or if any of the values is not yet known.
+func ASGCapacityOrdered(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("min_size") || !diff.NewValueKnown("desired_capacity") || !diff.NewValueKnown("max_size") {
+		return nil
+	}
+
+	// "desired_capacity" is Computed, so an unconfigured value is taken from the prior state.
+	if rawConfigAttribute(diff, "desired_capacity").IsNull() {
+		return nil
+	}
+
+	desired := diff.Get("desired_capacity").(int)
+	minSize, maxSize := diff.Get("min_size").(int), diff.Get("max_size").(int)
+
+	if desired < minSize {
+		return fmt.Errorf("'desired_capacity' (%d) must be greater than or equal to 'min_size' (%d)", desired, minSize)
+	}
+
+	if desired > maxSize {
+		return fmt.Errorf("'desired_capacity' (%d) must be less than or equal to 'max_size' (%d)", desired, maxSize)
+	}
+
+	return nil
+}
+
+// CertificateKeyPairMatches returns a CustomizeDiffFunc that tests that the public key of the PEM-encoded
+// certificate at certKey matches the PEM-encoded private key at keyKey, e.g. for IAM server certificates.
+// The check is skipped if either value is not set, not yet known or cannot be parsed;
+// use ValidCertificatePEM and ValidPrivateKeyPEM to validate the individual values.
+func CertificateKeyPairMatches(certKey, keyKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(certKey) || !diff.NewValueKnown(keyKey) {
+			return nil
+		}
+
+		certPEM, keyPEM := diff.Get(certKey).(string), diff.Get(keyKey).(string)
+		if certPEM == "" || keyPEM == "" {
+			return nil
+		}
+
+		cert, err := parseCertificatePEM(certPEM)
+		if err != nil {
+			return nil
+		}
+
+		key, err := parsePrivateKeyPEM(keyPEM)
+		if err != nil {
+			return nil
+		}
+
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil
+		}
+
+		if publicKey, ok := cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool }); !ok || !publicKey.Equal(signer.Public()) {
+			return fmt.Errorf("'%s' does not match the public key of the certificate in '%s'", keyKey, certKey)
+		}
+
+		return nil
+	}
+}
+
+// ParallelListsEqualLength returns a CustomizeDiffFunc that tests that the TypeList or TypeSet
+// attributes at keyA and keyB, whose elements correspond by position, have the same number of elements.
+// An attribute that is not set has no elements. The check is skipped if either value is not yet known.
+func ParallelListsEqualLength(keyA, keyB string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(keyA) || !diff.NewValueKnown(keyB) {
+			return nil
+		}
+
+		a, _ := listValue(diff.Get(keyA))
+		b, _ := listValue(diff.Get(keyB))
+
+		if len(a) != len(b) {
+			return fmt.Errorf("'%s' (%d elements) and '%s' (%d elements) must have the same number of elements", keyA, len(a), keyB, len(b))
+		}
+
+		return nil
+	}
+}
+
+// TGWASNDistinct returns a CustomizeDiffFunc that tests that the Amazon-side ASN at amazonKey and
+// the customer-side ASN at customerKey, when both are set, are not the same.
+// The ASN attributes may be either TypeInt or TypeString; use Valid4ByteASN to validate string values.
+func TGWASNDistinct(amazonKey, customerKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(amazonKey) || !diff.NewValueKnown(customerKey) {
+			return nil
+		}
+
+		amazonASN, ok := diff.GetOk(amazonKey)
+		if !ok {
+			return nil
+		}
+
+		customerASN, ok := diff.GetOk(customerKey)
+		if !ok {
+			return nil
+		}
+
+		if a, c := fmt.Sprint(amazonASN), fmt.Sprint(customerASN); a == c {
+			return fmt.Errorf("'%s' (%s) and '%s' (%s) must not be the same ASN", amazonKey, a, customerKey, c)
+		}
+
+		return nil
+	}
+}
+
+// eniRoutingRoleKeywords are words in a network interface's description or Name tag that imply
+// the interface forwards traffic on behalf of other hosts.
+var eniRoutingRoleKeywords = []string{
+	"firewall",
+	"nat",
+	"router",
+	"vpn",
+}
+
+// eniIPForwardingMarkers are substrings of instance user data that enable IP forwarding.
+var eniIPForwardingMarkers = []string{
+	"net.ipv4.ip_forward=1",
+	"net.ipv4.ip_forward = 1",
+	"net.ipv6.conf.all.forwarding=1",
+	"net.ipv6.conf.all.forwarding = 1",
+}
+
+// ENISourceDestCheckConsistency is a CustomizeDiffFunc that logs a warning if "source_dest_check" is true
+// but other attributes imply that the network interface or instance routes traffic for other hosts,
+// e.g. a NAT instance, in which case the check must be disabled for traffic to flow.
+// The role is inferred from the "description" and "tags" Name values and from "user_data" that enables
+// IP forwarding.
+func ENISourceDestCheckConsistency(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("source_dest_check") {
+		return nil
+	}
+
+	if v, ok := diff.Get("source_dest_check").(bool); !ok || !v {
+		return nil
+	}
+
+	description, _ := diff.Get("description").(string)
+	tags, _ := diff.Get("tags").(map[string]interface{})
+	name, _ := tags["Name"].(string)
+	userData, _ := diff.Get("user_data").(string)
+
+	if reason, ok := eniImpliesRouting(description, name, userData); ok {
+		log.Printf("[WARN] 'source_dest_check' is true but %s; disable the check if this interface forwards traffic for other hosts", reason)
+	}
+
+	return nil
+}
+
+// eniImpliesRouting returns whether the specified network interface or instance attributes imply that
+// it routes traffic for other hosts, and why.
+func eniImpliesRouting(description, name, userData string) (string, bool) {
+	for _, v := range []struct {
+		attribute, value string
+	}{
+		{"'description'", description},
+		{"Name tag", name},
+	} {
+		words := strings.FieldsFunc(strings.ToLower(v.value), func(r rune) bool {
+			return !('a' <= r && r <= 'z' || '0' <= r && r <= '9')
+		})
+
+		for _, keyword := range eniRoutingRoleKeywords {
+			if slices.Contains(words, keyword) {
+				return fmt.Sprintf("the %s (%s) implies a %s role", v.attribute, v.value, keyword), true
+			}
+		}
+	}
+
+	for _, marker := range eniIPForwardingMarkers {
+		if strings.Contains(userData, marker) {
+			return "'user_data' enables IP forwarding", true
+		}
+	}
+
+	return "", false
+}
+
+// ScalingStepBoundsValid returns a CustomizeDiffFunc that tests that the metric interval bounds of the
+// step adjustment configuration blocks at the specified key, e.g. "step_adjustment", neither overlap nor
+// leave gaps: exactly one step must have no "metric_interval_lower_bound" and exactly one step must have
+// no "metric_interval_upper_bound", and each step's upper bound must be the next step's lower bound.
+// Bounds are TypeString attributes, with an empty value meaning unbounded.
+func ScalingStepBoundsValid(key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+
+		return scalingStepBoundsValid(key, configurationBlocks(diff.Get(key)))
+	}
+}
+
+func scalingStepBoundsValid(key string, steps []map[string]interface{}) error {
+	if len(steps) == 0 {
+		return nil
+	}
+
+	type interval struct {
+		index        int
+		lower, upper float64
+	}
+
+	parseBound := func(i int, name string, unbounded float64) (float64, error) {
+		v, _ := steps[i][name].(string)
+		if v == "" {
+			return unbounded, nil
+		}
+
+		bound, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q: step %d: %s (%s) must be a number", key, i, name, v)
+		}
+
+		return bound, nil
+	}
+
+	intervals := make([]interval, 0, len(steps))
+	var unboundedLower, unboundedUpper int
+
+	for i := range steps {
+		lower, err := parseBound(i, "metric_interval_lower_bound", math.Inf(-1))
+		if err != nil {
+			return err
+		}
+
+		upper, err := parseBound(i, "metric_interval_upper_bound", math.Inf(1))
+		if err != nil {
+			return err
+		}
+
+		if lower >= upper {
+			return fmt.Errorf("%q: step %d: metric_interval_lower_bound (%g) must be less than metric_interval_upper_bound (%g)", key, i, lower, upper)
+		}
+
+		if math.IsInf(lower, -1) {
+			unboundedLower++
+		}
+		if math.IsInf(upper, 1) {
+			unboundedUpper++
+		}
+
+		intervals = append(intervals, interval{index: i, lower: lower, upper: upper})
+	}
+
+	if unboundedLower != 1 {
+		return fmt.Errorf("%q: exactly one step must have no metric_interval_lower_bound, got %d", key, unboundedLower)
+	}
+	if unboundedUpper != 1 {
+		return fmt.Errorf("%q: exactly one step must have no metric_interval_upper_bound, got %d", key, unboundedUpper)
+	}
+
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].lower < intervals[j].lower
+	})
+
+	for i := 1; i < len(intervals); i++ {
+		prev, curr := intervals[i-1], intervals[i]
+
+		switch {
+		case curr.lower < prev.upper:
+			return fmt.Errorf("%q: step %d: interval [%g, %g) overlaps step %d interval [%g, %g)", key, curr.index, curr.lower, curr.upper, prev.index, prev.lower, prev.upper)
+		case curr.lower > prev.upper:
+			return fmt.Errorf("%q: step %d: interval [%g, %g) leaves a gap after step %d interval [%g, %g)", key, curr.index, curr.lower, curr.upper, prev.index, prev.lower, prev.upper)
+		}
+	}
+
+	return nil
+}
+
+// emrMasterInstanceCounts are the supported EMR master instance group instance counts:
+// 1, or 3 for a cluster with multiple master nodes for high availability.
+var emrMasterInstanceCounts = []int{1, 3}
+
+// EMRMasterInstanceGroupValid is a CustomizeDiffFunc that tests that an EMR cluster's
+// "master_instance_group" configuration block is present and that its "instance_count" is
+// 1, or 3 for high availability.
+func EMRMasterInstanceGroupValid(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("master_instance_group") {
+		return nil
+	}
+
+	groups := configurationBlocks(diff.Get("master_instance_group"))
+	if len(groups) == 0 {
+		return fmt.Errorf("'master_instance_group' must be configured")
+	}
+
+	for _, group := range groups {
+		if count, ok := group["instance_count"].(int); ok && !slices.Contains(emrMasterInstanceCounts, count) {
+			return fmt.Errorf("'master_instance_group' 'instance_count' (%d) must be 1, or 3 for high availability", count)
+		}
+	}
+
+	return nil
+}
+
+// alarmAnomalyDetectionOperators are the CloudWatch alarm comparison operators used with anomaly detection bands.
+var alarmAnomalyDetectionOperators = []string{
+	"GreaterThanUpperThreshold",
+	"LessThanLowerOrGreaterThanUpperThreshold",
+	"LessThanLowerThreshold",
+}
+
+// AlarmThresholdConsistency is a CustomizeDiffFunc that tests that a CloudWatch metric alarm's
+// "comparison_operator" agrees with its threshold: anomaly detection operators require a
+// "threshold_metric_id" identifying a "metric_query" with an ANOMALY_DETECTION_BAND expression and
+// no static "threshold", while other operators require a static threshold and no "threshold_metric_id".
+// A configured threshold of 0 is a static threshold.
+func AlarmThresholdConsistency(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("comparison_operator") || !diff.NewValueKnown("threshold_metric_id") {
+		return nil
+	}
+
+	operator := diff.Get("comparison_operator").(string)
+	if operator == "" {
+		return nil
+	}
+
+	threshold := rawConfigAttribute(diff, "threshold")
+	if !threshold.IsKnown() {
+		return nil
+	}
+
+	thresholdMetricID := diff.Get("threshold_metric_id").(string)
+	hasThreshold := !threshold.IsNull()
+
+	if !slices.Contains(alarmAnomalyDetectionOperators, operator) {
+		if thresholdMetricID != "" {
+			return fmt.Errorf("'threshold_metric_id' (%s) can only be set with an anomaly detection 'comparison_operator' (%s), got %s", thresholdMetricID, strings.Join(alarmAnomalyDetectionOperators, ", "), operator)
+		}
+		if !hasThreshold {
+			return fmt.Errorf("'threshold' must be set with 'comparison_operator' %s", operator)
+		}
+		return nil
+	}
+
+	if hasThreshold {
+		return fmt.Errorf("'threshold' must not be set with anomaly detection 'comparison_operator' %s; use 'threshold_metric_id'", operator)
+	}
+
+	if thresholdMetricID == "" {
+		return fmt.Errorf("'threshold_metric_id' must be set with anomaly detection 'comparison_operator' %s", operator)
+	}
+
+	if !diff.NewValueKnown("metric_query") {
+		return nil
+	}
+
+	for _, query := range configurationBlocks(diff.Get("metric_query")) {
+		if id, _ := query["id"].(string); id != thresholdMetricID {
+			continue
+		}
+
+		if expression, _ := query["expression"].(string); !strings.Contains(strings.ToUpper(expression), "ANOMALY_DETECTION_BAND") {
+			return fmt.Errorf("'threshold_metric_id' (%s) must identify a 'metric_query' with an ANOMALY_DETECTION_BAND expression, got %q", thresholdMetricID, expression)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("'threshold_metric_id' (%s) must identify a 'metric_query' with an ANOMALY_DETECTION_BAND expression", thresholdMetricID)
+}
+
+// ebsProvisionedIOPSLimits maps each EBS Provisioned IOPS volume type to its IOPS limits.
+// See https://docs.aws.amazon.com/ebs/latest/userguide/provisioned-iops.html.
+var ebsProvisionedIOPSLimits = map[string]struct {
+	min, max, ratio int // ratio is the maximum IOPS per GiB of volume size.
+}{
+	"io1": {min: 100, max: 64000, ratio: 50},
+	"io2": {min: 100, max: 256000, ratio: 1000},
+}
+
+// EBSIOPSRatioValid returns a CustomizeDiffFunc that tests that the IOPS configured at iopsKey are within the
+// limits of the Provisioned IOPS volume type at volumeTypeKey for the volume size (GiB) at sizeKey.
+// Other volume types are not checked.
+func EBSIOPSRatioValid(volumeTypeKey, sizeKey, iopsKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(volumeTypeKey) || !diff.NewValueKnown(sizeKey) || !diff.NewValueKnown(iopsKey) {
+			return nil
+		}
+
+		volumeType := diff.Get(volumeTypeKey).(string)
+		limits, ok := ebsProvisionedIOPSLimits[volumeType]
+		if !ok {
+			return nil
+		}
+
+		v, ok := diff.GetOk(iopsKey)
+		if !ok {
+			return nil
+		}
+		iops := v.(int)
+
+		if iops < limits.min {
+			return fmt.Errorf("'%s' (%d) must be at least %d for '%s' %q", iopsKey, iops, limits.min, volumeTypeKey, volumeType)
+		}
+
+		v, ok = diff.GetOk(sizeKey)
+		if !ok {
+			return nil
+		}
+		size := v.(int)
+
+		maxIOPS := size * limits.ratio
+		if maxIOPS > limits.max {
+			maxIOPS = limits.max
+		}
+
+		if iops > maxIOPS {
+			return fmt.Errorf("'%s' (%d) must be at most %d for a %d GiB %q volume (%d IOPS per GiB, up to %d)", iopsKey, iops, maxIOPS, size, volumeType, limits.ratio, limits.max)
+		}
+
+		return nil
+	}
+}
+
+// ASGHealthCheckConsistency is a CustomizeDiffFunc that logs a warning if an Auto Scaling group's
+// "health_check_type" is "ELB" but neither "load_balancers" nor "target_group_arns" is configured,
+// or if "health_check_grace_period" is explicitly configured without ELB health checks.
+func ASGHealthCheckConsistency(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("health_check_type") || !diff.NewValueKnown("load_balancers") || !diff.NewValueKnown("target_group_arns") {
+		return nil
+	}
+
+	// An unknown set is planned as known and empty, so the configuration is checked too.
+	if !rawConfigAttribute(diff, "load_balancers").IsWhollyKnown() || !rawConfigAttribute(diff, "target_group_arns").IsWhollyKnown() {
+		return nil
+	}
+
+	healthCheckType := diff.Get("health_check_type").(string)
+	attached := diff.Get("load_balancers").(*schema.Set).Len() > 0 || diff.Get("target_group_arns").(*schema.Set).Len() > 0
+
+	// "health_check_grace_period" has a default value, so only an explicitly configured value is considered.
+	gracePeriodConfigured := !rawConfigAttribute(diff, "health_check_grace_period").IsNull()
+
+	for _, warning := range asgHealthCheckWarnings(healthCheckType, attached, gracePeriodConfigured) {
+		log.Printf("[WARN] %s", warning)
+	}
+
+	return nil
+}
+
+// asgHealthCheckWarnings returns the warnings for the specified Auto Scaling group health check configuration.
+// An empty health check type is the default, "EC2".
+func asgHealthCheckWarnings(healthCheckType string, attached, gracePeriodConfigured bool) []string {
+	var warnings []string
+
+	if healthCheckType == "ELB" && !attached {
+		warnings = append(warnings, "'health_check_type' is \"ELB\" but no 'load_balancers' or 'target_group_arns' are attached; instances will be considered healthy based on EC2 status checks only")
+	}
+
+	if healthCheckType == "" {
+		healthCheckType = "EC2"
+	}
+
+	if healthCheckType != "ELB" && gracePeriodConfigured {
+		warnings = append(warnings, fmt.Sprintf("'health_check_grace_period' is only meaningful with ELB health checks, but 'health_check_type' is %q", healthCheckType))
+	}
+
+	return warnings
+}
+
+// DynamoDBBillingConsistency is a CustomizeDiffFunc that tests that a DynamoDB table's "read_capacity" and
+// "write_capacity" are consistent with its "billing_mode": both must be configured for "PROVISIONED" tables and
+// neither may be configured for "PAY_PER_REQUEST" (on-demand) tables.
+// The capacities are Computed, so the configuration rather than the planned value is checked.
+func DynamoDBBillingConsistency(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("billing_mode") {
+		return nil
+	}
+
+	billingMode := diff.Get("billing_mode").(string)
+
+	for _, key := range []string{"read_capacity", "write_capacity"} {
+		v := rawConfigAttribute(diff, key)
+		if !v.IsKnown() {
+			return nil
+		}
+
+		switch billingMode {
+		case "PAY_PER_REQUEST":
+			if !v.IsNull() {
+				return fmt.Errorf("'%s' (%s) cannot be set when 'billing_mode' is %q", key, v.AsBigFloat().String(), billingMode)
+			}
+		case "PROVISIONED":
+			if v.IsNull() {
+				return fmt.Errorf("'%s' must be set when 'billing_mode' is %q", key, billingMode)
+			}
+		}
+	}
+
+	return nil
+}
+
+// kmsKeySpecUsages maps each KMS key spec to its supported key usages.
+// See https://docs.aws.amazon.com/kms/latest/developerguide/asymmetric-key-specs.html.
+var kmsKeySpecUsages = map[string][]string{
+	"SYMMETRIC_DEFAULT": {"ENCRYPT_DECRYPT"},
+	"RSA_2048":          {"ENCRYPT_DECRYPT", "SIGN_VERIFY"},
+	"RSA_3072":          {"ENCRYPT_DECRYPT", "SIGN_VERIFY"},
+	"RSA_4096":          {"ENCRYPT_DECRYPT", "SIGN_VERIFY"},
+	"ECC_NIST_P256":     {"SIGN_VERIFY", "KEY_AGREEMENT"},
+	"ECC_NIST_P384":     {"SIGN_VERIFY", "KEY_AGREEMENT"},
+	"ECC_NIST_P521":     {"SIGN_VERIFY", "KEY_AGREEMENT"},
+	"ECC_SECG_P256K1":   {"SIGN_VERIFY"},
+	"HMAC_224":          {"GENERATE_VERIFY_MAC"},
+	"HMAC_256":          {"GENERATE_VERIFY_MAC"},
+	"HMAC_384":          {"GENERATE_VERIFY_MAC"},
+	"HMAC_512":          {"GENERATE_VERIFY_MAC"},
+	"SM2":               {"ENCRYPT_DECRYPT", "SIGN_VERIFY", "KEY_AGREEMENT"},
+}
+
+// KMSKeySpecUsageConsistency is a CustomizeDiffFunc that tests that a KMS key's "key_usage" is supported
+// by its "customer_master_key_spec", e.g. "SIGN_VERIFY" requires an asymmetric key spec and
+// "ENCRYPT_DECRYPT" is not supported by elliptic curve key specs.
+func KMSKeySpecUsageConsistency(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("customer_master_key_spec") || !diff.NewValueKnown("key_usage") {
+		return nil
+	}
+
+	keySpec, keyUsage := diff.Get("customer_master_key_spec").(string), diff.Get("key_usage").(string)
+	if keySpec == "" || keyUsage == "" {
+		return nil
+	}
+
+	usages, ok := kmsKeySpecUsages[keySpec]
+	if !ok {
+		return nil
+	}
+
+	if !slices.Contains(usages, keyUsage) {
+		return fmt.Errorf("'key_usage' (%s) is not supported with 'customer_master_key_spec' (%s), expected one of %q", keyUsage, keySpec, usages)
+	}
+
+	return nil
+}
+
+// S3ReplicationRuleConsistency returns a CustomizeDiffFunc that tests that each S3 replication rule at the
+// specified key has a "destination" configuration block with a "bucket" ARN and, when there is more than one
+// rule, that each rule has a "priority" and that the priorities are unique. Rules are identified by their "id"
+// values, or by their indices if no "id" is configured. Rules whose destination bucket is not yet known are not
+// checked for one, and priorities that are not yet known are not compared.
+func S3ReplicationRuleConsistency(key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+
+		bucketKnown := func(i int) bool {
+			return diff.NewValueKnown(fmt.Sprintf("%s.%d.destination.0.bucket", key, i))
+		}
+
+		// An unset "priority" is planned as 0, so the configuration is checked.
+		priority := func(i int) cty.Value {
+			return rawConfigAttribute(diff, fmt.Sprintf("%s.%d.priority", key, i))
+		}
+
+		return s3ReplicationRuleConsistency(key, configurationBlocks(diff.Get(key)), bucketKnown, priority)
+	}
+}
+
+func s3ReplicationRuleConsistency(key string, rules []map[string]interface{}, bucketKnown func(int) bool, rawPriority func(int) cty.Value) error {
+	var errs []error
+	ids := make(map[int][]string)
+
+	for i, rule := range rules {
+		id, _ := rule["id"].(string)
+		if id == "" {
+			id = strconv.Itoa(i)
+		}
+
+		if destinations := configurationBlocks(rule["destination"]); len(destinations) == 0 {
+			errs = append(errs, fmt.Errorf("%q: rule (%s): destination is required", key, id))
+		} else if bucket, _ := destinations[0]["bucket"].(string); bucket == "" && bucketKnown(i) {
+			errs = append(errs, fmt.Errorf("%q: rule (%s): destination bucket is required", key, id))
+		}
+
+		if len(rules) < 2 {
+			continue
+		}
+
+		switch v := rawPriority(i); {
+		case v.IsNull():
+			errs = append(errs, fmt.Errorf("%q: rule (%s): priority is required when multiple rules exist", key, id))
+		case v.IsKnown():
+			priority, _ := rule["priority"].(int)
+			ids[priority] = append(ids[priority], id)
+		}
+	}
+
+	priorities := maps.Keys(ids)
+	slices.Sort(priorities)
+
+	for _, priority := range priorities {
+		if v := ids[priority]; len(v) > 1 {
+			errs = append(errs, fmt.Errorf("%q: rules (%s) have the same priority (%d); priorities must be unique", key, strings.Join(v, ", "), priority))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// GlueWorkerConsistency is a CustomizeDiffFunc that tests that a Glue job's "worker_type" and
+// "number_of_workers" are configured together, and that neither is combined with the deprecated
+// "max_capacity". "max_capacity" is computed from the worker settings by the API, so the configuration
+// rather than the planned value is checked.
+func GlueWorkerConsistency(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("worker_type") || !diff.NewValueKnown("number_of_workers") {
+		return nil
+	}
+
+	workerType, workerTypeOk := diff.GetOk("worker_type")
+	numberOfWorkers, numberOfWorkersOk := diff.GetOk("number_of_workers")
+
+	if maxCapacity := rawConfigAttribute(diff, "max_capacity"); (workerTypeOk || numberOfWorkersOk) && maxCapacity.IsKnown() && !maxCapacity.IsNull() {
+		v, _ := maxCapacity.AsBigFloat().Float64()
+		return fmt.Errorf("'max_capacity' (%g) cannot be combined with 'worker_type' or 'number_of_workers'", v)
+	}
+
+	if workerTypeOk && !numberOfWorkersOk {
+		return fmt.Errorf("'number_of_workers' must be set when 'worker_type' (%s) is set", workerType.(string))
+	}
+
+	if numberOfWorkersOk && !workerTypeOk {
+		return fmt.Errorf("'worker_type' must be set when 'number_of_workers' (%d) is set", numberOfWorkers.(int))
+	}
+
+	return nil
+}
+
+// rdsEngineVersionFormats are the version formats of the RDS engine families, keyed by engine name prefix.
+var rdsEngineVersionFormats = []struct {
+	enginePrefix string
+	pattern      *regexp.Regexp
+	example      string
+}{
+	{"aurora-mysql", regexache.MustCompile(`^\d\.\d+(\.mysql_aurora\.\d+\.\d+\.\d+)?$`), "8.0.mysql_aurora.3.05.2"},
+	{"aurora-postgresql", regexache.MustCompile(`^(9\.\d|\d{2})(\.\d+)?$`), "15.4"},
+	{"mariadb", regexache.MustCompile(`^\d{2}\.\d+(\.\d+)?$`), "10.11.5"},
+	{"mysql", regexache.MustCompile(`^\d\.\d+(\.\d+)?$`), "8.0.35"},
+	{"oracle-", regexache.MustCompile(`^\d{2}(\.\d+)+`), "19.0.0.0.ru-2023-10.rur-2023-10.r1"},
+	{"postgres", regexache.MustCompile(`^(9\.\d|\d{2})(\.\d+)?$`), "15.4"},
+	{"sqlserver-", regexache.MustCompile(`^\d{2}\.\d{2}(\.\d+){2}\.v\d+$`), "15.00.4335.1.v1"},
+}
+
+// RDSEngineVersionConsistency returns a CustomizeDiffFunc that logs a warning if the RDS engine version at
+// versionKey does not have the shape of a version of the engine at engineKey, e.g. "15.4" for "mysql".
+// Only a warning is logged so that newly released version formats are not blocked.
+// Engines with no known version format are not checked.
+func RDSEngineVersionConsistency(engineKey, versionKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(engineKey) || !diff.NewValueKnown(versionKey) {
+			return nil
+		}
+
+		engine, version := diff.Get(engineKey).(string), diff.Get(versionKey).(string)
+
+		if example, ok := rdsEngineVersionMismatch(engine, version); ok {
+			log.Printf("[WARN] '%s' (%s) does not look like a '%s' (%s) version, e.g. %q", versionKey, version, engineKey, engine, example)
+		}
+
+		return nil
+	}
+}
+
+// rdsEngineVersionMismatch returns whether the specified version does not have the shape of a version of
+// the specified engine and, if so, an example version of the engine.
+func rdsEngineVersionMismatch(engine, version string) (string, bool) {
+	if engine == "" || version == "" {
+		return "", false
+	}
+
+	for _, v := range rdsEngineVersionFormats {
+		if strings.HasPrefix(engine, v.enginePrefix) {
+			return v.example, !v.pattern.MatchString(version)
+		}
+	}
+
+	return "", false
+}
+
+// S3ObjectLockRetentionConsistency returns a CustomizeDiffFunc that tests that each S3 Object Lock default retention
+// configuration block at the specified key, e.g. "rule.0.default_retention", specifies exactly one of "days" and "years".
+func S3ObjectLockRetentionConsistency(key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+
+		var errs []error
+
+		for i, retention := range configurationBlocks(diff.Get(key)) {
+			if !diff.NewValueKnown(fmt.Sprintf("%s.%d.days", key, i)) || !diff.NewValueKnown(fmt.Sprintf("%s.%d.years", key, i)) {
+				continue
+			}
+
+			days, _ := retention["days"].(int)
+			years, _ := retention["years"].(int)
+
+			switch {
+			case days != 0 && years != 0:
+				errs = append(errs, fmt.Errorf("%q: days (%d) and years (%d) cannot both be set", key, days, years))
+			case days == 0 && years == 0:
+				errs = append(errs, fmt.Errorf("%q: one of days or years must be set", key))
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+}
+
+// placementGroupUnsupportedTenancies maps each EC2 instance tenancy to the placement group strategies
+// that do not support it. Dedicated Hosts cannot be launched in placement groups, and spread placement
+// groups do not support Dedicated Instances.
+// See https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/placement-groups.html#concepts-placement-groups.
+var placementGroupUnsupportedTenancies = map[string][]string{
+	"dedicated": {"spread"},
+	"host":      {"cluster", "partition", "spread"},
+}
+
+// PlacementTenancyConsistency returns a CustomizeDiffFunc that tests that the EC2 instance tenancy at
+// tenancyKey is supported by the placement group strategy at strategyKey, e.g. that a "cluster"
+// placement group is not combined with "host" tenancy.
+func PlacementTenancyConsistency(tenancyKey, strategyKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(tenancyKey) || !diff.NewValueKnown(strategyKey) {
+			return nil
+		}
+
+		tenancy, strategy := diff.Get(tenancyKey).(string), diff.Get(strategyKey).(string)
+
+		if slices.Contains(placementGroupUnsupportedTenancies[tenancy], strategy) {
+			return fmt.Errorf("'%s' (%s) is not supported with '%s' (%s) placement groups", tenancyKey, tenancy, strategyKey, strategy)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,2266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/convert"
+	"github.com/hashicorp/go-cty/cty/gocty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// unknownVariableValue is the sentinel the SDK uses to represent unknown values in raw configurations.
+const unknownVariableValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+type customizeDiffTestCase struct {
+	config      map[string]interface{}
+	state       map[string]string // Prior state attributes; nil for a new resource.
+	expectedErr *regexp.Regexp
+}
+
+type customizeDiffWarningTestCase struct {
+	config          map[string]interface{}
+	expectedWarning *regexp.Regexp // nil if no warning is expected.
+}
+
+func runCustomizeDiffTestCases(t *testing.T, s map[string]*schema.Schema, f schema.CustomizeDiffFunc, cases map[string]customizeDiffTestCase) {
+	t.Helper()
+
+	r := &schema.Resource{
+		Schema:        s,
+		CustomizeDiff: f,
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := simpleDiff(t, r, tc.config, tc.state)
+
+			if tc.expectedErr == nil {
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error matching \"%s\", got none", tc.expectedErr)
+			}
+
+			if !tc.expectedErr.MatchString(err.Error()) {
+				t.Fatalf("expected error matching \"%s\", got %s", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
+// runCustomizeDiffWarningTestCases runs a CustomizeDiffFunc that only logs warnings and checks the logged output.
+// Tests calling runCustomizeDiffWarningTestCases must not be run in parallel, as the standard logger is redirected.
+func runCustomizeDiffWarningTestCases(t *testing.T, s map[string]*schema.Schema, f schema.CustomizeDiffFunc, cases map[string]customizeDiffWarningTestCase) {
+	t.Helper()
+
+	r := &schema.Resource{
+		Schema:        s,
+		CustomizeDiff: f,
+	}
+
+	var buf bytes.Buffer
+	w := log.Writer()
+	log.SetOutput(&buf)
+	t.Cleanup(func() { log.SetOutput(w) })
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			buf.Reset()
+
+			if err := simpleDiff(t, r, tc.config, nil); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			var warnings []string
+			for _, line := range strings.Split(buf.String(), "\n") {
+				if _, warning, ok := strings.Cut(line, "[WARN] "); ok {
+					warnings = append(warnings, warning)
+				}
+			}
+
+			if tc.expectedWarning == nil {
+				if len(warnings) != 0 {
+					t.Fatalf("expected no warning, got %q", warnings)
+				}
+				return
+			}
+
+			if len(warnings) != 1 {
+				t.Fatalf("expected 1 warning matching \"%s\", got %q", tc.expectedWarning, warnings)
+			}
+
+			if !tc.expectedWarning.MatchString(warnings[0]) {
+				t.Fatalf("expected warning matching \"%s\", got %q", tc.expectedWarning, warnings[0])
+			}
+		})
+	}
+}
+
+// simpleDiff plans the specified configuration against the prior state attributes, if any.
+func simpleDiff(t *testing.T, r *schema.Resource, config map[string]interface{}, attributes map[string]string) error {
+	t.Helper()
+
+	// The SDK copies the raw configuration from the prior state into the diff.
+	state := &terraform.InstanceState{
+		Attributes: attributes,
+		RawConfig:  rawConfigValue(t, r.CoreConfigSchema().ImpliedType(), config),
+	}
+	if attributes != nil {
+		state.ID = "test"
+	}
+
+	_, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+
+	return err
+}
+
+// rawConfigValue converts a test configuration to a value of the given type,
+// as Terraform core would send it to the provider.
+func rawConfigValue(t *testing.T, ty cty.Type, v interface{}) cty.Value {
+	t.Helper()
+
+	if v == nil {
+		return cty.NullVal(ty)
+	}
+	if v, ok := v.(string); ok && v == unknownVariableValue {
+		return cty.UnknownVal(ty)
+	}
+
+	switch {
+	case ty.IsObjectType():
+		m := v.(map[string]interface{})
+		attrs := make(map[string]cty.Value)
+		for name, attrTy := range ty.AttributeTypes() {
+			attrs[name] = rawConfigValue(t, attrTy, m[name])
+		}
+		return cty.ObjectVal(attrs)
+	case ty.IsListType(), ty.IsSetType():
+		var vals []cty.Value
+		for _, v := range v.([]interface{}) {
+			vals = append(vals, rawConfigValue(t, ty.ElementType(), v))
+		}
+		switch {
+		case len(vals) == 0 && ty.IsListType():
+			return cty.ListValEmpty(ty.ElementType())
+		case len(vals) == 0:
+			return cty.SetValEmpty(ty.ElementType())
+		case ty.IsListType():
+			return cty.ListVal(vals)
+		default:
+			return cty.SetVal(vals)
+		}
+	case ty.IsMapType():
+		vals := make(map[string]cty.Value)
+		for k, v := range v.(map[string]interface{}) {
+			vals[k] = rawConfigValue(t, ty.ElementType(), v)
+		}
+		if len(vals) == 0 {
+			return cty.MapValEmpty(ty.ElementType())
+		}
+		return cty.MapVal(vals)
+	}
+
+	impliedTy, err := gocty.ImpliedType(v)
+	if err != nil {
+		t.Fatalf("converting %#v to %s: %s", v, ty.FriendlyName(), err)
+	}
+	val, err := gocty.ToCtyValue(v, impliedTy)
+	if err != nil {
+		t.Fatalf("converting %#v to %s: %s", v, ty.FriendlyName(), err)
+	}
+	val, err = convert.Convert(val, ty)
+	if err != nil {
+		t.Fatalf("converting %#v to %s: %s", v, ty.FriendlyName(), err)
+	}
+
+	return val
+}
+
+func TestWAFRulePrioritiesUnique(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"rule": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"priority": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+
+	runCustomizeDiffTestCases(t, s, WAFRulePrioritiesUnique("rule"), map[string]customizeDiffTestCase{
+		"no rules": {
+			config: map[string]interface{}{},
+		},
+		"unique priorities": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{"name": "rule-1", "priority": 1},
+					map[string]interface{}{"name": "rule-2", "priority": 2},
+					map[string]interface{}{"name": "rule-3", "priority": 3},
+				},
+			},
+		},
+		"duplicate priorities": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{"name": "rule-1", "priority": 1},
+					map[string]interface{}{"name": "rule-2", "priority": 2},
+					map[string]interface{}{"name": "rule-3", "priority": 1},
+				},
+			},
+			expectedErr: regexache.MustCompile(`"rule": rules \(rule-1, rule-3\) have the same priority \(1\)`),
+		},
+	})
+}
+
+func TestPrivateIPCountMatchesList(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"private_ip_list": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"private_ips_count": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+	}
+
+	runCustomizeDiffTestCases(t, s, PrivateIPCountMatchesList, map[string]customizeDiffTestCase{
+		"neither set": {
+			config: map[string]interface{}{},
+		},
+		"only list set": {
+			config: map[string]interface{}{
+				"private_ip_list": []interface{}{"10.0.0.10", "10.0.0.11"},
+			},
+		},
+		"only count set": {
+			config: map[string]interface{}{
+				"private_ips_count": 2,
+			},
+		},
+		"matching": {
+			config: map[string]interface{}{
+				"private_ip_list":   []interface{}{"10.0.0.10", "10.0.0.11", "10.0.0.12"},
+				"private_ips_count": 2,
+			},
+		},
+		"mismatching": {
+			config: map[string]interface{}{
+				"private_ip_list":   []interface{}{"10.0.0.10", "10.0.0.11"},
+				"private_ips_count": 3,
+			},
+			expectedErr: regexache.MustCompile(`'private_ips_count' \(3\) must equal the number of secondary private IP addresses in 'private_ip_list' \(1\)`),
+		},
+		"unknown list": {
+			config: map[string]interface{}{
+				"private_ip_list":   unknownVariableValue,
+				"private_ips_count": 3,
+			},
+		},
+	})
+}
+
+func TestRoute53RoutingPolicyConsistent(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"set_identifier": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+	for _, key := range []string{"failover_routing_policy", "geolocation_routing_policy", "latency_routing_policy", "weighted_routing_policy"} {
+		s[key] = &schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"value": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+
+	runCustomizeDiffTestCases(t, s, Route53RoutingPolicyConsistent, map[string]customizeDiffTestCase{
+		"simple routing": {
+			config: map[string]interface{}{},
+		},
+		"weighted": {
+			config: map[string]interface{}{
+				"set_identifier":          "primary",
+				"weighted_routing_policy": []interface{}{map[string]interface{}{"value": "10"}},
+			},
+		},
+		"failover": {
+			config: map[string]interface{}{
+				"set_identifier":          "primary",
+				"failover_routing_policy": []interface{}{map[string]interface{}{"value": "PRIMARY"}},
+			},
+		},
+		"unknown set_identifier": {
+			config: map[string]interface{}{
+				"set_identifier":         unknownVariableValue,
+				"latency_routing_policy": []interface{}{map[string]interface{}{"value": "us-west-2"}}, //lintignore:AWSAT003
+			},
+		},
+		"conflicting policies": {
+			config: map[string]interface{}{
+				"set_identifier":             "primary",
+				"geolocation_routing_policy": []interface{}{map[string]interface{}{"value": "US"}},
+				"weighted_routing_policy":    []interface{}{map[string]interface{}{"value": "10"}},
+			},
+			expectedErr: regexache.MustCompile(`only one routing policy can be configured, got geolocation_routing_policy, weighted_routing_policy`),
+		},
+		"missing set_identifier": {
+			config: map[string]interface{}{
+				"latency_routing_policy": []interface{}{map[string]interface{}{"value": "us-west-2"}}, //lintignore:AWSAT003
+			},
+			expectedErr: regexache.MustCompile(`'set_identifier' must be set when 'latency_routing_policy' is configured`),
+		},
+		"set_identifier without policy": {
+			config: map[string]interface{}{
+				"set_identifier": "primary",
+			},
+			expectedErr: regexache.MustCompile(`'set_identifier' \(primary\) must not be set without a routing policy`),
+		},
+	})
+}
+
+func TestEFSProvisionedThroughputConsistency(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"provisioned_throughput_in_mibps": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+		},
+		"throughput_mode": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "bursting",
+		},
+	}
+
+	runCustomizeDiffTestCases(t, s, EFSProvisionedThroughputConsistency, map[string]customizeDiffTestCase{
+		"default": {
+			config: map[string]interface{}{},
+		},
+		"bursting": {
+			config: map[string]interface{}{
+				"throughput_mode": "bursting",
+			},
+		},
+		"elastic": {
+			config: map[string]interface{}{
+				"throughput_mode": "elastic",
+			},
+		},
+		"provisioned with throughput": {
+			config: map[string]interface{}{
+				"throughput_mode":                 "provisioned",
+				"provisioned_throughput_in_mibps": 256.0,
+			},
+		},
+		"provisioned without throughput": {
+			config: map[string]interface{}{
+				"throughput_mode": "provisioned",
+			},
+			expectedErr: regexache.MustCompile(`'provisioned_throughput_in_mibps' must be set when 'throughput_mode' is 'provisioned'`),
+		},
+		"bursting with throughput": {
+			config: map[string]interface{}{
+				"throughput_mode":                 "bursting",
+				"provisioned_throughput_in_mibps": 256.0,
+			},
+			expectedErr: regexache.MustCompile(`'provisioned_throughput_in_mibps' must not be set when 'throughput_mode' is 'bursting'`),
+		},
+		"unknown throughput": {
+			config: map[string]interface{}{
+				"throughput_mode":                 "provisioned",
+				"provisioned_throughput_in_mibps": unknownVariableValue,
+			},
+		},
+	})
+}
+
+func TestValidateListDiff(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"vpc_security_group_ids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	runCustomizeDiffTestCases(t, s, ValidateListDiff("vpc_security_group_ids", SecurityGroupIDsWithinLimit(2)), map[string]customizeDiffTestCase{
+		"not set": {
+			config: map[string]interface{}{},
+		},
+		"valid": {
+			config: map[string]interface{}{
+				"vpc_security_group_ids": []interface{}{"sg-12345678", "sg-87654321"},
+			},
+		},
+		"invalid": {
+			config: map[string]interface{}{
+				"vpc_security_group_ids": []interface{}{"sg-12345678", "sg-87654321", "sg-11111111"},
+			},
+			expectedErr: regexache.MustCompile(`Expected at most 2 security group IDs, got 3`),
+		},
+		"unknown": {
+			config: map[string]interface{}{
+				"vpc_security_group_ids": unknownVariableValue,
+			},
+		},
+	})
+}
+
+func TestValidFargateCPUMemory(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"cpu": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"memory": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	runCustomizeDiffTestCases(t, s, ValidFargateCPUMemory, map[string]customizeDiffTestCase{
+		"not set": {
+			config: map[string]interface{}{},
+		},
+		"valid pair": {
+			config: map[string]interface{}{
+				"cpu":    "256",
+				"memory": "512",
+			},
+		},
+		"valid pair large": {
+			config: map[string]interface{}{
+				"cpu":    "16384",
+				"memory": "122880",
+			},
+		},
+		"invalid pair": {
+			config: map[string]interface{}{
+				"cpu":    "256",
+				"memory": "4096",
+			},
+			expectedErr: regexache.MustCompile(`'memory' \(4096\) is not supported with 'cpu' \(256\) on Fargate, expected one of \[512 1024 2048\]`),
+		},
+		"invalid step": {
+			config: map[string]interface{}{
+				"cpu":    "8192",
+				"memory": "17408",
+			},
+			expectedErr: regexache.MustCompile(`'memory' \(17408\) is not supported with 'cpu' \(8192\)`),
+		},
+		"unknown CPU value": {
+			config: map[string]interface{}{
+				"cpu":    "300",
+				"memory": "512",
+			},
+			expectedErr: regexache.MustCompile(`'cpu' \(300\) is not a supported Fargate CPU value, expected one of \[256 512 1024 2048 4096 8192 16384\]`),
+		},
+		"unknown memory": {
+			config: map[string]interface{}{
+				"cpu":    "256",
+				"memory": unknownVariableValue,
+			},
+		},
+	})
+}
+
+func TestBucketPolicyReferencesBucket(t *testing.T) { //nolint:paralleltest
+
+	s := map[string]*schema.Schema{
+		"bucket": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"policy": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	runCustomizeDiffWarningTestCases(t, s, BucketPolicyReferencesBucket("bucket"), map[string]customizeDiffWarningTestCase{
+		"references bucket": {
+			config: map[string]interface{}{
+				"bucket": "my-bucket",
+				"policy": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::my-bucket/*"}]}`, //lintignore:AWSAT005
+			},
+		},
+		"references other bucket": {
+			config: map[string]interface{}{
+				"bucket": "my-bucket",
+				"policy": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::other-bucket/*"}]}`, //lintignore:AWSAT005
+			},
+			expectedWarning: regexache.MustCompile(`^S3 Bucket \(my-bucket\) policy has no statement with a Resource referencing the bucket`),
+		},
+	})
+
+	for _, tc := range []struct {
+		name     string
+		policy   string
+		expected bool
+	}{
+		{
+			name:     "bucket objects",
+			policy:   `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::my-bucket/*"}]}`, //lintignore:AWSAT005
+			expected: true,
+		},
+		{
+			name:     "bucket",
+			policy:   `{"Statement":{"Effect":"Allow","Action":"s3:ListBucket","Resource":"arn:aws-us-gov:s3:::my-bucket"}}`, //lintignore:AWSAT005
+			expected: true,
+		},
+		{
+			name:     "wildcard partition in list",
+			policy:   `{"Statement":[{"Effect":"Deny","Action":"s3:*","Resource":["arn:aws:s3:::other-bucket","arn:*:s3:::my-bucket/prefix/*"]}]}`, //lintignore:AWSAT005
+			expected: true,
+		},
+		{
+			name:     "other bucket",
+			policy:   `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::other-bucket/*"}]}`, //lintignore:AWSAT005
+			expected: false,
+		},
+		{
+			name:     "bucket name prefix",
+			policy:   `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::my-bucket-logs/*"}]}`, //lintignore:AWSAT005
+			expected: false,
+		},
+		{
+			name:     "no resource",
+			policy:   `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","NotResource":"arn:aws:s3:::my-bucket/*"}]}`, //lintignore:AWSAT005
+			expected: false,
+		},
+	} {
+		got, err := bucketPolicyReferencesBucket(tc.policy, "my-bucket")
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+		} else if got != tc.expected {
+			t.Errorf("%s: expected %t, got %t", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestSNSFIFOConsistency(t *testing.T) {
+	t.Parallel()
+
+	topic := map[string]*schema.Schema{
+		"content_based_deduplication": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"fifo_topic": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+	}
+
+	runCustomizeDiffTestCases(t, topic, SNSFIFOConsistency, map[string]customizeDiffTestCase{
+		"standard topic": {
+			config: map[string]interface{}{
+				"name": "my-topic",
+			},
+		},
+		"FIFO topic with deduplication": {
+			config: map[string]interface{}{
+				"name":                        "my-topic.fifo",
+				"fifo_topic":                  true,
+				"content_based_deduplication": true,
+			},
+		},
+		"FIFO topic without suffix": {
+			config: map[string]interface{}{
+				"name":       "my-topic",
+				"fifo_topic": true,
+			},
+			expectedErr: regexache.MustCompile(`'name' \(my-topic\) must end with '.fifo' when 'fifo_topic' is true`),
+		},
+		"standard topic with deduplication": {
+			config: map[string]interface{}{
+				"name":                        "my-topic",
+				"content_based_deduplication": true,
+			},
+			expectedErr: regexache.MustCompile(`'content_based_deduplication' can only be set for FIFO topics`),
+		},
+	})
+
+	subscription := map[string]*schema.Schema{
+		"protocol": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"topic_arn": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+
+	runCustomizeDiffTestCases(t, subscription, SNSFIFOConsistency, map[string]customizeDiffTestCase{
+		"FIFO topic SQS subscription": {
+			config: map[string]interface{}{
+				"protocol":  "sqs",
+				"topic_arn": "arn:aws:sns:us-west-2:123456789012:my-topic.fifo", //lintignore:AWSAT003,AWSAT005
+			},
+		},
+		"FIFO topic email subscription": {
+			config: map[string]interface{}{
+				"protocol":  "email",
+				"topic_arn": "arn:aws:sns:us-west-2:123456789012:my-topic.fifo", //lintignore:AWSAT003,AWSAT005
+			},
+			expectedErr: regexache.MustCompile(`'protocol' \(email\) is not supported for subscriptions to FIFO topic`),
+		},
+		"FIFO topic Lambda subscription": {
+			config: map[string]interface{}{
+				"protocol":  "lambda",
+				"topic_arn": "arn:aws:sns:us-west-2:123456789012:my-topic.fifo", //lintignore:AWSAT003,AWSAT005
+			},
+			expectedErr: regexache.MustCompile(`'protocol' \(lambda\) is not supported for subscriptions to FIFO topic`),
+		},
+		"standard topic email subscription": {
+			config: map[string]interface{}{
+				"protocol":  "email",
+				"topic_arn": "arn:aws:sns:us-west-2:123456789012:my-topic", //lintignore:AWSAT003,AWSAT005
+			},
+		},
+		"unknown topic": {
+			config: map[string]interface{}{
+				"protocol":  "email",
+				"topic_arn": unknownVariableValue,
+			},
+		},
+	})
+}
+
+func TestIntMatchesListLength(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"ipv6_address_count": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"ipv6_addresses": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	runCustomizeDiffTestCases(t, s, IntMatchesListLength("ipv6_address_count", "ipv6_addresses"), map[string]customizeDiffTestCase{
+		"neither set": {
+			config: map[string]interface{}{},
+		},
+		"only count set": {
+			config: map[string]interface{}{
+				"ipv6_address_count": 2,
+			},
+		},
+		"matching": {
+			config: map[string]interface{}{
+				"ipv6_address_count": 2,
+				"ipv6_addresses":     []interface{}{"2001:db8::1", "2001:db8::2"},
+			},
+		},
+		"mismatching": {
+			config: map[string]interface{}{
+				"ipv6_address_count": 3,
+				"ipv6_addresses":     []interface{}{"2001:db8::1", "2001:db8::2"},
+			},
+			expectedErr: regexache.MustCompile(`'ipv6_address_count' \(3\) must equal the number of elements in 'ipv6_addresses' \(2\)`),
+		},
+		"computed count": {
+			config: map[string]interface{}{
+				"ipv6_address_count": unknownVariableValue,
+				"ipv6_addresses":     []interface{}{"2001:db8::1", "2001:db8::2"},
+			},
+		},
+		"computed list": {
+			config: map[string]interface{}{
+				"ipv6_address_count": 3,
+				"ipv6_addresses":     unknownVariableValue,
+			},
+		},
+	})
+}
+
+func TestS3LifecycleTransitionsConsistent(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"rule": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"transition": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"date": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"days": {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+								"storage_class": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	runCustomizeDiffTestCases(t, s, S3LifecycleTransitionsConsistent("rule"), map[string]customizeDiffTestCase{
+		"no rules": {
+			config: map[string]interface{}{},
+		},
+		"valid": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"id": "archive",
+						"transition": []interface{}{
+							map[string]interface{}{"days": 30, "storage_class": "STANDARD_IA"},
+							map[string]interface{}{"days": 90, "storage_class": "GLACIER"},
+							map[string]interface{}{"days": 365, "storage_class": "DEEP_ARCHIVE"},
+						},
+					},
+					map[string]interface{}{
+						"id": "dated",
+						"transition": []interface{}{
+							map[string]interface{}{"date": "2030-01-01T00:00:00Z", "storage_class": "GLACIER"},
+						},
+					},
+				},
+			},
+		},
+		"days and date": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"id": "archive",
+						"transition": []interface{}{
+							map[string]interface{}{"days": 30, "date": "2030-01-01T00:00:00Z", "storage_class": "GLACIER"},
+						},
+					},
+				},
+			},
+			expectedErr: regexache.MustCompile(`"rule": rule \(archive\): transition \(GLACIER\) cannot specify both days \(30\) and date \(2030-01-01T00:00:00Z\)`),
+		},
+		"days out of order": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"id": "archive",
+						"transition": []interface{}{
+							map[string]interface{}{"days": 90, "storage_class": "STANDARD_IA"},
+							map[string]interface{}{"days": 30, "storage_class": "GLACIER"},
+						},
+					},
+				},
+			},
+			expectedErr: regexache.MustCompile(`"rule": rule \(archive\): transition \(GLACIER\) days \(30\) must be greater than transition \(STANDARD_IA\) days \(90\)`),
+		},
+		"duplicate days": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"id": "archive",
+						"transition": []interface{}{
+							map[string]interface{}{"days": 30, "storage_class": "STANDARD_IA"},
+							map[string]interface{}{"days": 30, "storage_class": "GLACIER"},
+						},
+					},
+				},
+			},
+			expectedErr: regexache.MustCompile(`transition \(GLACIER\) days \(30\) must be greater than transition \(STANDARD_IA\) days \(30\)`),
+		},
+	})
+
+	// Transitions without a "days" value, e.g. from callers with a different schema, must not panic.
+	rules := []map[string]interface{}{
+		{
+			"id": "archive",
+			"transition": []interface{}{
+				map[string]interface{}{"storage_class": "STANDARD_IA"},
+				map[string]interface{}{"days": 30, "storage_class": "GLACIER"},
+			},
+		},
+	}
+	if err := s3LifecycleTransitionsConsistent("rule", rules); err != nil {
+		t.Errorf("expected no error, got %s", err)
+	}
+}
+
+func TestASGCapacityOrdered(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"desired_capacity": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"max_size": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"min_size": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+	}
+
+	runCustomizeDiffTestCases(t, s, ASGCapacityOrdered, map[string]customizeDiffTestCase{
+		"ordered": {
+			config: map[string]interface{}{
+				"min_size":         1,
+				"desired_capacity": 2,
+				"max_size":         3,
+			},
+		},
+		"equal": {
+			config: map[string]interface{}{
+				"min_size":         2,
+				"desired_capacity": 2,
+				"max_size":         2,
+			},
+		},
+		"desired unset": {
+			config: map[string]interface{}{
+				"min_size": 1,
+				"max_size": 3,
+			},
+		},
+		"desired unset with prior state": {
+			config: map[string]interface{}{
+				"min_size": 1,
+				"max_size": 3,
+			},
+			state: map[string]string{
+				"min_size":         "5",
+				"desired_capacity": "5",
+				"max_size":         "5",
+			},
+		},
+		"desired unknown": {
+			config: map[string]interface{}{
+				"min_size":         1,
+				"desired_capacity": unknownVariableValue,
+				"max_size":         3,
+			},
+		},
+		"desired below min": {
+			config: map[string]interface{}{
+				"min_size":         2,
+				"desired_capacity": 1,
+				"max_size":         3,
+			},
+			expectedErr: regexache.MustCompile(`'desired_capacity' \(1\) must be greater than or equal to 'min_size' \(2\)`),
+		},
+		"desired zero below min": {
+			config: map[string]interface{}{
+				"min_size":         1,
+				"desired_capacity": 0,
+				"max_size":         3,
+			},
+			expectedErr: regexache.MustCompile(`'desired_capacity' \(0\) must be greater than or equal to 'min_size' \(1\)`),
+		},
+		"desired above max": {
+			config: map[string]interface{}{
+				"min_size":         1,
+				"desired_capacity": 4,
+				"max_size":         3,
+			},
+			expectedErr: regexache.MustCompile(`'desired_capacity' \(4\) must be less than or equal to 'max_size' \(3\)`),
+		},
+	})
+}
+
+func TestCertificateKeyPairMatches(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"certificate_body": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"private_key": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+	}
+
+	rsaKey, ecKey := testRSAPrivateKey(t), testECPrivateKey(t)
+	rsaCertificate, ecCertificate := testSelfSignedCertificatePEM(t, rsaKey), testSelfSignedCertificatePEM(t, ecKey)
+
+	runCustomizeDiffTestCases(t, s, CertificateKeyPairMatches("certificate_body", "private_key"), map[string]customizeDiffTestCase{
+		"not set": {
+			config: map[string]interface{}{},
+		},
+		"matching RSA pair": {
+			config: map[string]interface{}{
+				"certificate_body": rsaCertificate,
+				"private_key":      testPKCS8PrivateKeyPEM(t, rsaKey),
+			},
+		},
+		"matching EC pair": {
+			config: map[string]interface{}{
+				"certificate_body": ecCertificate,
+				"private_key":      testPKCS8PrivateKeyPEM(t, ecKey),
+			},
+		},
+		"unknown private key": {
+			config: map[string]interface{}{
+				"certificate_body": rsaCertificate,
+				"private_key":      unknownVariableValue,
+			},
+		},
+		"mismatched pair": {
+			config: map[string]interface{}{
+				"certificate_body": rsaCertificate,
+				"private_key":      testPKCS8PrivateKeyPEM(t, testRSAPrivateKey(t)),
+			},
+			expectedErr: regexache.MustCompile(`'private_key' does not match the public key of the certificate in 'certificate_body'`),
+		},
+		"mismatched key type": {
+			config: map[string]interface{}{
+				"certificate_body": rsaCertificate,
+				"private_key":      testPKCS8PrivateKeyPEM(t, ecKey),
+			},
+			expectedErr: regexache.MustCompile(`'private_key' does not match the public key of the certificate in 'certificate_body'`),
+		},
+	})
+}
+
+func TestParallelListsEqualLength(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"arns": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"names": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	runCustomizeDiffTestCases(t, s, ParallelListsEqualLength("arns", "names"), map[string]customizeDiffTestCase{
+		"neither set": {
+			config: map[string]interface{}{},
+		},
+		"equal": {
+			config: map[string]interface{}{
+				"arns":  []interface{}{"arn:aws:sns:us-west-2:123456789012:a", "arn:aws:sns:us-west-2:123456789012:b"}, // lintignore:AWSAT003,AWSAT005
+				"names": []interface{}{"a", "b"},
+			},
+		},
+		"unequal": {
+			config: map[string]interface{}{
+				"arns":  []interface{}{"arn:aws:sns:us-west-2:123456789012:a", "arn:aws:sns:us-west-2:123456789012:b"}, // lintignore:AWSAT003,AWSAT005
+				"names": []interface{}{"a"},
+			},
+			expectedErr: regexache.MustCompile(`'arns' \(2 elements\) and 'names' \(1 elements\) must have the same number of elements`),
+		},
+		"only one set": {
+			config: map[string]interface{}{
+				"names": []interface{}{"a"},
+			},
+			expectedErr: regexache.MustCompile(`'arns' \(0 elements\) and 'names' \(1 elements\) must have the same number of elements`),
+		},
+		"computed list": {
+			config: map[string]interface{}{
+				"arns":  unknownVariableValue,
+				"names": []interface{}{"a"},
+			},
+		},
+	})
+}
+
+func TestTGWASNDistinct(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"amazon_side_asn": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"bgp_asn": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: Valid4ByteASN,
+		},
+	}
+
+	runCustomizeDiffTestCases(t, s, TGWASNDistinct("amazon_side_asn", "bgp_asn"), map[string]customizeDiffTestCase{
+		"neither set": {
+			config: map[string]interface{}{},
+		},
+		"only amazon set": {
+			config: map[string]interface{}{
+				"amazon_side_asn": 64512,
+			},
+		},
+		"distinct": {
+			config: map[string]interface{}{
+				"amazon_side_asn": 64512,
+				"bgp_asn":         "65000",
+			},
+		},
+		"4-byte distinct": {
+			config: map[string]interface{}{
+				"amazon_side_asn": 4200000000,
+				"bgp_asn":         "4200000001",
+			},
+		},
+		"equal": {
+			config: map[string]interface{}{
+				"amazon_side_asn": 65000,
+				"bgp_asn":         "65000",
+			},
+			expectedErr: regexache.MustCompile(`'amazon_side_asn' \(65000\) and 'bgp_asn' \(65000\) must not be the same ASN`),
+		},
+		"computed customer ASN": {
+			config: map[string]interface{}{
+				"amazon_side_asn": 65000,
+				"bgp_asn":         unknownVariableValue,
+			},
+		},
+	})
+}
+
+func TestENISourceDestCheckConsistency(t *testing.T) { //nolint:paralleltest
+
+	s := map[string]*schema.Schema{
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"source_dest_check": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	runCustomizeDiffWarningTestCases(t, s, ENISourceDestCheckConsistency, map[string]customizeDiffWarningTestCase{
+		"default": {
+			config: map[string]interface{}{},
+		},
+		"NAT with check enabled": {
+			config: map[string]interface{}{
+				"description": "NAT instance",
+			},
+			expectedWarning: regexache.MustCompile(`^'source_dest_check' is true but the 'description' \(NAT instance\) implies a nat role`),
+		},
+		"NAT with check disabled": {
+			config: map[string]interface{}{
+				"description":       "NAT instance",
+				"source_dest_check": false,
+			},
+		},
+	})
+
+	for _, tc := range []struct {
+		name        string
+		description string
+		tagName     string
+		userData    string
+		expected    bool
+	}{
+		{
+			name: "no role",
+		},
+		{
+			name:        "web server",
+			description: "web server interface",
+			tagName:     "web-01",
+		},
+		{
+			name:        "keyword within word",
+			description: "internal signature service",
+			tagName:     "international",
+		},
+		{
+			name:        "NAT description",
+			description: "NAT instance",
+			expected:    true,
+		},
+		{
+			name:     "router Name tag",
+			tagName:  "edge-router-1",
+			expected: true,
+		},
+		{
+			name:     "IP forwarding user data",
+			userData: "#!/bin/bash\nsysctl -w net.ipv4.ip_forward=1\n",
+			expected: true,
+		},
+	} {
+		if _, got := eniImpliesRouting(tc.description, tc.tagName, tc.userData); got != tc.expected {
+			t.Errorf("%s: expected %t, got %t", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestScalingStepBoundsValid(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"step_adjustment": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metric_interval_lower_bound": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"metric_interval_upper_bound": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"scaling_adjustment": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+
+	step := func(lower, upper string, adjustment int) map[string]interface{} {
+		m := map[string]interface{}{"scaling_adjustment": adjustment}
+		if lower != "" {
+			m["metric_interval_lower_bound"] = lower
+		}
+		if upper != "" {
+			m["metric_interval_upper_bound"] = upper
+		}
+		return m
+	}
+
+	runCustomizeDiffTestCases(t, s, ScalingStepBoundsValid("step_adjustment"), map[string]customizeDiffTestCase{
+		"no steps": {
+			config: map[string]interface{}{},
+		},
+		"single unbounded step": {
+			config: map[string]interface{}{
+				"step_adjustment": []interface{}{
+					step("", "", 1),
+				},
+			},
+		},
+		"contiguous steps": {
+			config: map[string]interface{}{
+				"step_adjustment": []interface{}{
+					step("20", "", 3),
+					step("", "0", -1),
+					step("0", "10", 1),
+					step("10", "20", 2),
+				},
+			},
+		},
+		"overlapping steps": {
+			config: map[string]interface{}{
+				"step_adjustment": []interface{}{
+					step("", "0", -1),
+					step("0", "15", 1),
+					step("10", "", 2),
+				},
+			},
+			expectedErr: regexache.MustCompile(`"step_adjustment": step 2: interval \[10, \+Inf\) overlaps step 1 interval \[0, 15\)`),
+		},
+		"gap": {
+			config: map[string]interface{}{
+				"step_adjustment": []interface{}{
+					step("", "0", -1),
+					step("0", "10", 1),
+					step("20", "", 2),
+				},
+			},
+			expectedErr: regexache.MustCompile(`"step_adjustment": step 2: interval \[20, \+Inf\) leaves a gap after step 1 interval \[0, 10\)`),
+		},
+		"two unbounded upper": {
+			config: map[string]interface{}{
+				"step_adjustment": []interface{}{
+					step("", "0", -1),
+					step("0", "", 1),
+					step("10", "", 2),
+				},
+			},
+			expectedErr: regexache.MustCompile(`exactly one step must have no metric_interval_upper_bound, got 2`),
+		},
+		"no unbounded lower": {
+			config: map[string]interface{}{
+				"step_adjustment": []interface{}{
+					step("0", "10", 1),
+					step("10", "", 2),
+				},
+			},
+			expectedErr: regexache.MustCompile(`exactly one step must have no metric_interval_lower_bound, got 0`),
+		},
+		"empty interval": {
+			config: map[string]interface{}{
+				"step_adjustment": []interface{}{
+					step("", "10", 1),
+					step("10", "10", 2),
+				},
+			},
+			expectedErr: regexache.MustCompile(`step 1: metric_interval_lower_bound \(10\) must be less than metric_interval_upper_bound \(10\)`),
+		},
+		"invalid bound": {
+			config: map[string]interface{}{
+				"step_adjustment": []interface{}{
+					step("", "ten", 1),
+				},
+			},
+			expectedErr: regexache.MustCompile(`step 0: metric_interval_upper_bound \(ten\) must be a number`),
+		},
+	})
+}
+
+func TestEMRMasterInstanceGroupValid(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"master_instance_group": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"instance_count": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Default:  1,
+					},
+					"instance_type": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+
+	runCustomizeDiffTestCases(t, s, EMRMasterInstanceGroupValid, map[string]customizeDiffTestCase{
+		"default count": {
+			config: map[string]interface{}{
+				"master_instance_group": []interface{}{
+					map[string]interface{}{"instance_type": "m5.xlarge"},
+				},
+			},
+		},
+		"high availability": {
+			config: map[string]interface{}{
+				"master_instance_group": []interface{}{
+					map[string]interface{}{"instance_type": "m5.xlarge", "instance_count": 3},
+				},
+			},
+		},
+		"missing": {
+			config:      map[string]interface{}{},
+			expectedErr: regexache.MustCompile(`'master_instance_group' must be configured`),
+		},
+		"count of 2": {
+			config: map[string]interface{}{
+				"master_instance_group": []interface{}{
+					map[string]interface{}{"instance_type": "m5.xlarge", "instance_count": 2},
+				},
+			},
+			expectedErr: regexache.MustCompile(`'master_instance_group' 'instance_count' \(2\) must be 1, or 3 for high availability`),
+		},
+	})
+}
+
+func TestAlarmThresholdConsistency(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"comparison_operator": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"metric_query": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"expression": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"id": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"threshold": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+		},
+		"threshold_metric_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	anomalyQueries := []interface{}{
+		map[string]interface{}{"id": "m1"},
+		map[string]interface{}{"id": "ad1", "expression": "ANOMALY_DETECTION_BAND(m1, 2)"},
+	}
+
+	runCustomizeDiffTestCases(t, s, AlarmThresholdConsistency, map[string]customizeDiffTestCase{
+		"static": {
+			config: map[string]interface{}{
+				"comparison_operator": "GreaterThanOrEqualToThreshold",
+				"threshold":           80.0,
+			},
+		},
+		"static zero": {
+			config: map[string]interface{}{
+				"comparison_operator": "LessThanOrEqualToThreshold",
+				"threshold":           0.0,
+			},
+		},
+		"static operator without threshold": {
+			config: map[string]interface{}{
+				"comparison_operator": "GreaterThanThreshold",
+			},
+			expectedErr: regexache.MustCompile(`'threshold' must be set with 'comparison_operator' GreaterThanThreshold`),
+		},
+		"unknown threshold": {
+			config: map[string]interface{}{
+				"comparison_operator": "GreaterThanThreshold",
+				"threshold":           unknownVariableValue,
+			},
+		},
+		"anomaly detection": {
+			config: map[string]interface{}{
+				"comparison_operator": "LessThanLowerOrGreaterThanUpperThreshold",
+				"threshold_metric_id": "ad1",
+				"metric_query":        anomalyQueries,
+			},
+		},
+		"static operator with threshold metric": {
+			config: map[string]interface{}{
+				"comparison_operator": "GreaterThanThreshold",
+				"threshold_metric_id": "ad1",
+				"metric_query":        anomalyQueries,
+			},
+			expectedErr: regexache.MustCompile(`'threshold_metric_id' \(ad1\) can only be set with an anomaly detection 'comparison_operator'`),
+		},
+		"anomaly operator with static threshold": {
+			config: map[string]interface{}{
+				"comparison_operator": "GreaterThanUpperThreshold",
+				"threshold":           80.0,
+			},
+			expectedErr: regexache.MustCompile(`'threshold' must not be set with anomaly detection 'comparison_operator' GreaterThanUpperThreshold`),
+		},
+		"anomaly operator with zero static threshold": {
+			config: map[string]interface{}{
+				"comparison_operator": "GreaterThanUpperThreshold",
+				"threshold":           0.0,
+				"threshold_metric_id": "ad1",
+				"metric_query":        anomalyQueries,
+			},
+			expectedErr: regexache.MustCompile(`'threshold' must not be set with anomaly detection 'comparison_operator' GreaterThanUpperThreshold`),
+		},
+		"anomaly operator without threshold metric": {
+			config: map[string]interface{}{
+				"comparison_operator": "LessThanLowerThreshold",
+			},
+			expectedErr: regexache.MustCompile(`'threshold_metric_id' must be set with anomaly detection 'comparison_operator' LessThanLowerThreshold`),
+		},
+		"threshold metric without anomaly band": {
+			config: map[string]interface{}{
+				"comparison_operator": "LessThanLowerThreshold",
+				"threshold_metric_id": "m1",
+				"metric_query":        anomalyQueries,
+			},
+			expectedErr: regexache.MustCompile(`'threshold_metric_id' \(m1\) must identify a 'metric_query' with an ANOMALY_DETECTION_BAND expression, got ""`),
+		},
+		"threshold metric not found": {
+			config: map[string]interface{}{
+				"comparison_operator": "LessThanLowerThreshold",
+				"threshold_metric_id": "ad2",
+				"metric_query":        anomalyQueries,
+			},
+			expectedErr: regexache.MustCompile(`'threshold_metric_id' \(ad2\) must identify a 'metric_query'`),
+		},
+		"unknown threshold metric": {
+			config: map[string]interface{}{
+				"comparison_operator": "LessThanLowerThreshold",
+				"threshold_metric_id": unknownVariableValue,
+			},
+		},
+	})
+}
+
+func TestEBSIOPSRatioValid(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"size": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"iops": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+	}
+
+	runCustomizeDiffTestCases(t, s, EBSIOPSRatioValid("type", "size", "iops"), map[string]customizeDiffTestCase{
+		"io1 in ratio": {
+			config: map[string]interface{}{
+				"type": "io1",
+				"size": 100,
+				"iops": 5000,
+			},
+		},
+		"io1 over ratio": {
+			config: map[string]interface{}{
+				"type": "io1",
+				"size": 100,
+				"iops": 5001,
+			},
+			expectedErr: regexache.MustCompile(`'iops' \(5001\) must be at most 5000 for a 100 GiB "io1" volume \(50 IOPS per GiB, up to 64000\)`),
+		},
+		"io1 over maximum": {
+			config: map[string]interface{}{
+				"type": "io1",
+				"size": 16384,
+				"iops": 64001,
+			},
+			expectedErr: regexache.MustCompile(`'iops' \(64001\) must be at most 64000 for a 16384 GiB "io1" volume`),
+		},
+		"io1 below minimum": {
+			config: map[string]interface{}{
+				"type": "io1",
+				"size": 100,
+				"iops": 50,
+			},
+			expectedErr: regexache.MustCompile(`'iops' \(50\) must be at least 100 for 'type' "io1"`),
+		},
+		"io2 in ratio": {
+			config: map[string]interface{}{
+				"type": "io2",
+				"size": 100,
+				"iops": 100000,
+			},
+		},
+		"io2 over ratio": {
+			config: map[string]interface{}{
+				"type": "io2",
+				"size": 10,
+				"iops": 10001,
+			},
+			expectedErr: regexache.MustCompile(`'iops' \(10001\) must be at most 10000 for a 10 GiB "io2" volume`),
+		},
+		"gp2 not checked": {
+			config: map[string]interface{}{
+				"type": "gp2",
+				"size": 1,
+				"iops": 100000,
+			},
+		},
+		"no iops": {
+			config: map[string]interface{}{
+				"type": "io1",
+				"size": 100,
+			},
+		},
+		"computed size": {
+			config: map[string]interface{}{
+				"type": "io1",
+				"size": unknownVariableValue,
+				"iops": 100000,
+			},
+		},
+	})
+}
+
+func TestASGHealthCheckConsistency(t *testing.T) { //nolint:paralleltest
+
+	s := map[string]*schema.Schema{
+		"health_check_grace_period": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  300,
+		},
+		"health_check_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"load_balancers": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"target_group_arns": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	runCustomizeDiffWarningTestCases(t, s, ASGHealthCheckConsistency, map[string]customizeDiffWarningTestCase{
+		"default": {
+			config: map[string]interface{}{},
+		},
+		"ELB without load balancer": {
+			config: map[string]interface{}{
+				"health_check_type": "ELB",
+			},
+			expectedWarning: regexache.MustCompile(`^'health_check_type' is "ELB" but no 'load_balancers' or 'target_group_arns' are attached`),
+		},
+		"EC2 with grace period": {
+			config: map[string]interface{}{
+				"health_check_grace_period": 60,
+				"health_check_type":         "EC2",
+			},
+			expectedWarning: regexache.MustCompile(`^'health_check_grace_period' is only meaningful with ELB health checks, but 'health_check_type' is "EC2"$`),
+		},
+		"computed target groups": {
+			config: map[string]interface{}{
+				"health_check_type": "ELB",
+				"target_group_arns": unknownVariableValue,
+			},
+		},
+	})
+
+	for _, tc := range []struct {
+		name                  string
+		healthCheckType       string
+		attached              bool
+		gracePeriodConfigured bool
+		expected              []string
+	}{
+		{
+			name: "default",
+		},
+		{
+			name:                  "ELB with target group and grace period",
+			healthCheckType:       "ELB",
+			attached:              true,
+			gracePeriodConfigured: true,
+		},
+		{
+			name:            "ELB without load balancer",
+			healthCheckType: "ELB",
+			expected:        []string{`'health_check_type' is "ELB" but no 'load_balancers' or 'target_group_arns' are attached`},
+		},
+		{
+			name:                  "EC2 with grace period",
+			healthCheckType:       "EC2",
+			gracePeriodConfigured: true,
+			expected:              []string{`'health_check_grace_period' is only meaningful with ELB health checks, but 'health_check_type' is "EC2"`},
+		},
+		{
+			name:                  "default type with grace period",
+			attached:              true,
+			gracePeriodConfigured: true,
+			expected:              []string{`'health_check_type' is "EC2"`},
+		},
+	} {
+		got := asgHealthCheckWarnings(tc.healthCheckType, tc.attached, tc.gracePeriodConfigured)
+		if len(got) != len(tc.expected) {
+			t.Errorf("%s: expected %d warnings, got %d: %q", tc.name, len(tc.expected), len(got), got)
+			continue
+		}
+		for i, want := range tc.expected {
+			if !strings.Contains(got[i], want) {
+				t.Errorf("%s: expected warning %q to include %q", tc.name, got[i], want)
+			}
+		}
+	}
+}
+
+func TestDynamoDBBillingConsistency(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"billing_mode": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "PROVISIONED",
+		},
+		"read_capacity": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"write_capacity": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+	}
+
+	runCustomizeDiffTestCases(t, s, DynamoDBBillingConsistency, map[string]customizeDiffTestCase{
+		"provisioned": {
+			config: map[string]interface{}{
+				"read_capacity":  5,
+				"write_capacity": 5,
+			},
+		},
+		"provisioned without capacity": {
+			config:      map[string]interface{}{},
+			expectedErr: regexache.MustCompile(`'read_capacity' must be set when 'billing_mode' is "PROVISIONED"`),
+		},
+		"provisioned without write capacity": {
+			config: map[string]interface{}{
+				"billing_mode":  "PROVISIONED",
+				"read_capacity": 5,
+			},
+			expectedErr: regexache.MustCompile(`'write_capacity' must be set when 'billing_mode' is "PROVISIONED"`),
+		},
+		"on-demand": {
+			config: map[string]interface{}{
+				"billing_mode": "PAY_PER_REQUEST",
+			},
+		},
+		"on-demand with read capacity": {
+			config: map[string]interface{}{
+				"billing_mode":  "PAY_PER_REQUEST",
+				"read_capacity": 5,
+			},
+			expectedErr: regexache.MustCompile(`'read_capacity' \(5\) cannot be set when 'billing_mode' is "PAY_PER_REQUEST"`),
+		},
+		"on-demand with write capacity": {
+			config: map[string]interface{}{
+				"billing_mode":   "PAY_PER_REQUEST",
+				"write_capacity": 10,
+			},
+			expectedErr: regexache.MustCompile(`'write_capacity' \(10\) cannot be set when 'billing_mode' is "PAY_PER_REQUEST"`),
+		},
+		"provisioned to on-demand": {
+			config: map[string]interface{}{
+				"billing_mode": "PAY_PER_REQUEST",
+			},
+			state: map[string]string{
+				"billing_mode":   "PROVISIONED",
+				"read_capacity":  "5",
+				"write_capacity": "5",
+			},
+		},
+		"on-demand to provisioned": {
+			config: map[string]interface{}{
+				"billing_mode":   "PROVISIONED",
+				"read_capacity":  5,
+				"write_capacity": 5,
+			},
+			state: map[string]string{
+				"billing_mode":   "PAY_PER_REQUEST",
+				"read_capacity":  "0",
+				"write_capacity": "0",
+			},
+		},
+		"on-demand with read capacity in state": {
+			config: map[string]interface{}{
+				"billing_mode":   "PAY_PER_REQUEST",
+				"write_capacity": 10,
+			},
+			state: map[string]string{
+				"billing_mode":   "PROVISIONED",
+				"read_capacity":  "5",
+				"write_capacity": "10",
+			},
+			expectedErr: regexache.MustCompile(`'write_capacity' \(10\) cannot be set when 'billing_mode' is "PAY_PER_REQUEST"`),
+		},
+		"computed read capacity": {
+			config: map[string]interface{}{
+				"read_capacity": unknownVariableValue,
+			},
+		},
+	})
+}
+
+func TestKMSKeySpecUsageConsistency(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"customer_master_key_spec": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "SYMMETRIC_DEFAULT",
+		},
+		"key_usage": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "ENCRYPT_DECRYPT",
+		},
+	}
+
+	cases := map[string]customizeDiffTestCase{
+		"default": {
+			config: map[string]interface{}{},
+		},
+		"symmetric sign": {
+			config: map[string]interface{}{
+				"key_usage": "SIGN_VERIFY",
+			},
+			expectedErr: regexache.MustCompile(`'key_usage' \(SIGN_VERIFY\) is not supported with 'customer_master_key_spec' \(SYMMETRIC_DEFAULT\), expected one of \["ENCRYPT_DECRYPT"\]`),
+		},
+		"ECC encrypt": {
+			config: map[string]interface{}{
+				"customer_master_key_spec": "ECC_NIST_P256",
+			},
+			expectedErr: regexache.MustCompile(`'key_usage' \(ENCRYPT_DECRYPT\) is not supported with 'customer_master_key_spec' \(ECC_NIST_P256\)`),
+		},
+		"HMAC sign": {
+			config: map[string]interface{}{
+				"customer_master_key_spec": "HMAC_256",
+				"key_usage":                "SIGN_VERIFY",
+			},
+			expectedErr: regexache.MustCompile(`'key_usage' \(SIGN_VERIFY\) is not supported with 'customer_master_key_spec' \(HMAC_256\)`),
+		},
+		"computed key spec": {
+			config: map[string]interface{}{
+				"customer_master_key_spec": unknownVariableValue,
+				"key_usage":                "SIGN_VERIFY",
+			},
+		},
+	}
+
+	for keySpec, usages := range kmsKeySpecUsages {
+		for _, keyUsage := range usages {
+			cases[fmt.Sprintf("%s %s", keySpec, keyUsage)] = customizeDiffTestCase{
+				config: map[string]interface{}{
+					"customer_master_key_spec": keySpec,
+					"key_usage":                keyUsage,
+				},
+			}
+		}
+	}
+
+	runCustomizeDiffTestCases(t, s, KMSKeySpecUsageConsistency, cases)
+}
+
+func TestS3ReplicationRuleConsistency(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"rule": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"destination": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"bucket": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+							},
+						},
+					},
+					"id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"priority": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+
+	destination := func(bucket interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{"bucket": bucket}}
+	}
+
+	runCustomizeDiffTestCases(t, s, S3ReplicationRuleConsistency("rule"), map[string]customizeDiffTestCase{
+		"no rules": {
+			config: map[string]interface{}{},
+		},
+		"single rule": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"id":          "all",
+						"destination": destination("arn:aws:s3:::destination"), // lintignore:AWSAT005
+					},
+				},
+			},
+		},
+		"valid ruleset": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"id":          "logs",
+						"priority":    1,
+						"destination": destination("arn:aws:s3:::logs-replica"), // lintignore:AWSAT005
+					},
+					map[string]interface{}{
+						"id":          "data",
+						"priority":    2,
+						"destination": destination("arn:aws:s3:::data-replica"), // lintignore:AWSAT005
+					},
+				},
+			},
+		},
+		"duplicate priorities": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"id":          "logs",
+						"priority":    1,
+						"destination": destination("arn:aws:s3:::logs-replica"), // lintignore:AWSAT005
+					},
+					map[string]interface{}{
+						"id":          "data",
+						"priority":    1,
+						"destination": destination("arn:aws:s3:::data-replica"), // lintignore:AWSAT005
+					},
+				},
+			},
+			expectedErr: regexache.MustCompile(`"rule": rules \(logs, data\) have the same priority \(1\); priorities must be unique`),
+		},
+		"multiple rules without priorities": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"id":          "logs",
+						"destination": destination("arn:aws:s3:::logs-replica"), // lintignore:AWSAT005
+					},
+					map[string]interface{}{
+						"id":          "data",
+						"destination": destination("arn:aws:s3:::data-replica"), // lintignore:AWSAT005
+					},
+				},
+			},
+			expectedErr: regexache.MustCompile(`"rule": rule \(logs\): priority is required when multiple rules exist\n"rule": rule \(data\): priority is required when multiple rules exist$`),
+		},
+		"multiple rules with one priority": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"id":          "logs",
+						"priority":    0,
+						"destination": destination("arn:aws:s3:::logs-replica"), // lintignore:AWSAT005
+					},
+					map[string]interface{}{
+						"id":          "data",
+						"destination": destination("arn:aws:s3:::data-replica"), // lintignore:AWSAT005
+					},
+				},
+			},
+			expectedErr: regexache.MustCompile(`^"rule": rule \(data\): priority is required when multiple rules exist$`),
+		},
+		"computed priority": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"id":          "logs",
+						"priority":    unknownVariableValue,
+						"destination": destination("arn:aws:s3:::logs-replica"), // lintignore:AWSAT005
+					},
+					map[string]interface{}{
+						"id":          "data",
+						"priority":    0,
+						"destination": destination("arn:aws:s3:::data-replica"), // lintignore:AWSAT005
+					},
+				},
+			},
+		},
+		"missing destination": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"id": "all",
+					},
+				},
+			},
+			expectedErr: regexache.MustCompile(`"rule": rule \(all\): destination is required`),
+		},
+		"missing destination bucket": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"destination": destination(""),
+					},
+				},
+			},
+			expectedErr: regexache.MustCompile(`"rule": rule \(0\): destination bucket is required`),
+		},
+		"computed destination bucket": {
+			config: map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"id":          "all",
+						"destination": destination(unknownVariableValue),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestGlueWorkerConsistency(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"max_capacity": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+			Computed: true,
+		},
+		"number_of_workers": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"worker_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	runCustomizeDiffTestCases(t, s, GlueWorkerConsistency, map[string]customizeDiffTestCase{
+		"neither": {
+			config: map[string]interface{}{},
+		},
+		"workers": {
+			config: map[string]interface{}{
+				"number_of_workers": 10,
+				"worker_type":       "G.1X",
+			},
+		},
+		"max capacity": {
+			config: map[string]interface{}{
+				"max_capacity": 10.0,
+			},
+		},
+		"worker type without number": {
+			config: map[string]interface{}{
+				"worker_type": "G.1X",
+			},
+			expectedErr: regexache.MustCompile(`'number_of_workers' must be set when 'worker_type' \(G.1X\) is set`),
+		},
+		"number without worker type": {
+			config: map[string]interface{}{
+				"number_of_workers": 10,
+			},
+			expectedErr: regexache.MustCompile(`'worker_type' must be set when 'number_of_workers' \(10\) is set`),
+		},
+		"max capacity with workers": {
+			config: map[string]interface{}{
+				"max_capacity":      10.0,
+				"number_of_workers": 10,
+				"worker_type":       "G.1X",
+			},
+			expectedErr: regexache.MustCompile(`'max_capacity' \(10\) cannot be combined with 'worker_type' or 'number_of_workers'`),
+		},
+		"max capacity with worker type": {
+			config: map[string]interface{}{
+				"max_capacity": 0.0625,
+				"worker_type":  "G.1X",
+			},
+			expectedErr: regexache.MustCompile(`'max_capacity' \(0.0625\) cannot be combined with 'worker_type' or 'number_of_workers'`),
+		},
+		"max capacity unchanged with workers": {
+			config: map[string]interface{}{
+				"max_capacity":      10.0,
+				"number_of_workers": 10,
+				"worker_type":       "G.1X",
+			},
+			state: map[string]string{
+				"max_capacity":      "10",
+				"number_of_workers": "10",
+				"worker_type":       "G.1X",
+			},
+			expectedErr: regexache.MustCompile(`'max_capacity' \(10\) cannot be combined with 'worker_type' or 'number_of_workers'`),
+		},
+		"computed max capacity with workers": {
+			config: map[string]interface{}{
+				"number_of_workers": 10,
+				"worker_type":       "G.1X",
+			},
+			state: map[string]string{
+				"max_capacity":      "10",
+				"number_of_workers": "10",
+				"worker_type":       "G.1X",
+			},
+		},
+		"unknown max capacity with workers": {
+			config: map[string]interface{}{
+				"max_capacity":      unknownVariableValue,
+				"number_of_workers": 10,
+				"worker_type":       "G.1X",
+			},
+		},
+		"computed number of workers": {
+			config: map[string]interface{}{
+				"number_of_workers": unknownVariableValue,
+				"worker_type":       "G.1X",
+			},
+		},
+	})
+}
+
+func TestRDSEngineVersionConsistency(t *testing.T) { //nolint:paralleltest
+
+	s := map[string]*schema.Schema{
+		"engine": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"engine_version": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	runCustomizeDiffWarningTestCases(t, s, RDSEngineVersionConsistency("engine", "engine_version"), map[string]customizeDiffWarningTestCase{
+		"matched": {
+			config: map[string]interface{}{
+				"engine":         "mysql",
+				"engine_version": "8.0.35",
+			},
+		},
+		"mismatched": {
+			config: map[string]interface{}{
+				"engine":         "mysql",
+				"engine_version": "15.4",
+			},
+			expectedWarning: regexache.MustCompile(`^'engine_version' \(15\.4\) does not look like a 'engine' \(mysql\) version, e\.g\. "8\.0\.35"$`),
+		},
+		"computed version": {
+			config: map[string]interface{}{
+				"engine":         "postgres",
+				"engine_version": unknownVariableValue,
+			},
+		},
+	})
+
+	for _, tc := range []struct {
+		engine   string
+		version  string
+		mismatch bool
+	}{
+		{"mysql", "8.0.35", false},
+		{"mysql", "8.0", false},
+		{"mysql", "5.7.44", false},
+		{"postgres", "15.4", false},
+		{"postgres", "16", false},
+		{"postgres", "9.6.24", false},
+		{"aurora-mysql", "8.0.mysql_aurora.3.05.2", false},
+		{"aurora-mysql", "5.7", false},
+		{"aurora-postgresql", "15.4", false},
+		{"mariadb", "10.11.5", false},
+		{"oracle-ee", "19.0.0.0.ru-2023-10.rur-2023-10.r1", false},
+		{"sqlserver-se", "15.00.4335.1.v1", false},
+		{"custom-engine", "anything", false},
+		{"mysql", "", false},
+		{"mysql", "15.4", true},
+		{"postgres", "8.0.35", true},
+		{"aurora-mysql", "15.4", true},
+		{"aurora-postgresql", "8.0.mysql_aurora.3.05.2", true},
+		{"mariadb", "8.0.35", true},
+		{"sqlserver-ee", "15.4", true},
+	} {
+		if _, got := rdsEngineVersionMismatch(tc.engine, tc.version); got != tc.mismatch {
+			t.Errorf("%s %s: expected mismatch %t, got %t", tc.engine, tc.version, tc.mismatch, got)
+		}
+	}
+}
+
+func TestS3ObjectLockRetentionConsistency(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"default_retention": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"days": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"mode": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"years": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+
+	retention := func(m map[string]interface{}) map[string]interface{} {
+		m["mode"] = "GOVERNANCE"
+		return map[string]interface{}{"default_retention": []interface{}{m}}
+	}
+
+	runCustomizeDiffTestCases(t, s, S3ObjectLockRetentionConsistency("default_retention"), map[string]customizeDiffTestCase{
+		"no retention": {
+			config: map[string]interface{}{},
+		},
+		"days only": {
+			config: retention(map[string]interface{}{"days": 30}),
+		},
+		"years only": {
+			config: retention(map[string]interface{}{"years": 1}),
+		},
+		"both": {
+			config:      retention(map[string]interface{}{"days": 30, "years": 1}),
+			expectedErr: regexache.MustCompile(`"default_retention": days \(30\) and years \(1\) cannot both be set`),
+		},
+		"neither": {
+			config:      retention(map[string]interface{}{}),
+			expectedErr: regexache.MustCompile(`"default_retention": one of days or years must be set`),
+		},
+		"computed days": {
+			config: retention(map[string]interface{}{"days": unknownVariableValue}),
+		},
+	})
+}
+
+func TestPlacementTenancyConsistency(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"placement_strategy": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"tenancy": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	cases := map[string]customizeDiffTestCase{
+		"neither": {
+			config: map[string]interface{}{},
+		},
+		"host without placement group": {
+			config: map[string]interface{}{
+				"tenancy": "host",
+			},
+		},
+		"computed strategy": {
+			config: map[string]interface{}{
+				"placement_strategy": unknownVariableValue,
+				"tenancy":            "host",
+			},
+		},
+	}
+
+	for _, tenancy := range []string{"", "default", "dedicated", "host"} {
+		for _, strategy := range []string{"cluster", "partition", "spread"} {
+			tc := customizeDiffTestCase{
+				config: map[string]interface{}{
+					"placement_strategy": strategy,
+					"tenancy":            tenancy,
+				},
+			}
+
+			if tenancy == "host" || tenancy == "dedicated" && strategy == "spread" {
+				tc.expectedErr = regexache.MustCompile(fmt.Sprintf(`'tenancy' \(%s\) is not supported with 'placement_strategy' \(%s\) placement groups`, tenancy, strategy))
+			}
+
+			cases[fmt.Sprintf("%s tenancy %s strategy", tenancy, strategy)] = tc
+		}
+	}
+
+	runCustomizeDiffTestCases(t, s, PlacementTenancyConsistency("tenancy", "placement_strategy"), cases)
+}
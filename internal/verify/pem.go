@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// parseCertificatePEM parses the first PEM block of the specified string as an X.509 certificate.
+func parseCertificatePEM(s string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(s)))
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	if block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("unexpected PEM block type %q, expected \"CERTIFICATE\"", block.Type)
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// parsePrivateKeyPEM parses the first PEM block of the specified string as a private key
+// in PKCS #1, PKCS #8 or SEC 1 (EC) form.
+func parsePrivateKeyPEM(s string) (crypto.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(s)))
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("PEM block of type %q is not a PKCS #1, PKCS #8 or EC private key", block.Type)
+}
+
+// ValidCertificatePEM validates that a string value is a PEM-encoded X.509 certificate.
+func ValidCertificatePEM(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := parseCertificatePEM(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid PEM-encoded certificate: %s", k, err))
+	}
+
+	return
+}
+
+// ValidPrivateKeyPEM validates that a string value is a PEM-encoded private key.
+func ValidPrivateKeyPEM(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := parsePrivateKeyPEM(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid PEM-encoded private key: %s", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testRSAPrivateKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key
+}
+
+func testECPrivateKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key
+}
+
+func testPEM(blockType string, b []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: b}))
+}
+
+func testPKCS8PrivateKeyPEM(t *testing.T, key crypto.PrivateKey) string {
+	t.Helper()
+
+	b, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return testPEM("PRIVATE KEY", b)
+}
+
+func testSelfSignedCertificatePEM(t *testing.T, key crypto.Signer) string {
+	t.Helper()
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	b, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return testPEM("CERTIFICATE", b)
+}
+
+func TestValidCertificatePEM(t *testing.T) {
+	t.Parallel()
+
+	validCertificates := []string{
+		testSelfSignedCertificatePEM(t, testRSAPrivateKey(t)),
+		testSelfSignedCertificatePEM(t, testECPrivateKey(t)),
+		"\n" + testSelfSignedCertificatePEM(t, testECPrivateKey(t)) + "\n",
+	}
+	for _, v := range validCertificates {
+		_, errors := ValidCertificatePEM(v, "certificate_body")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid certificate: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"", "no PEM block found"},
+		{"not a certificate", "no PEM block found"},
+		{testPEM("CERTIFICATE", []byte("garbage")), "x509:"},
+		{testPKCS8PrivateKeyPEM(t, testECPrivateKey(t)), `unexpected PEM block type "PRIVATE KEY"`},
+	}
+	for _, tc := range cases {
+		_, errors := ValidCertificatePEM(tc.Value, "certificate_body")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidPrivateKeyPEM(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, ecKey := testRSAPrivateKey(t), testECPrivateKey(t)
+	ecDER, err := x509.MarshalECPrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validKeys := []string{
+		testPEM("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey)),
+		testPKCS8PrivateKeyPEM(t, rsaKey),
+		testPKCS8PrivateKeyPEM(t, ecKey),
+		testPEM("EC PRIVATE KEY", ecDER),
+	}
+	for _, v := range validKeys {
+		_, errors := ValidPrivateKeyPEM(v, "private_key")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid private key: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"", "no PEM block found"},
+		{"not a key", "no PEM block found"},
+		{testPEM("RSA PRIVATE KEY", []byte("garbage")), `PEM block of type "RSA PRIVATE KEY" is not a PKCS #1, PKCS #8 or EC private key`},
+		{testSelfSignedCertificatePEM(t, ecKey), `PEM block of type "CERTIFICATE" is not`},
+	}
+	for _, tc := range cases {
+		_, errors := ValidPrivateKeyPEM(tc.Value, "private_key")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
@@ -6,22 +6,47 @@ package verify
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	"math/big"
 	"net"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"regexp/syntax"
+	"sort"
 	"strconv"
 	"strings"
+	"text/template"
+	"text/template/parse"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
+	"github.com/jmespath/go-jmespath"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v2"
 )
 
+// clock returns the current time for time-dependent validators. Overridden in tests.
+var clock func() time.Time = time.Now
+
+var rateExpressionRegexp = regexache.MustCompile(`^rate\((\d+) (minutes?|hours?|days?)\)$`)
+var cronExpressionRegexp = regexache.MustCompile(`^cron\((.*)\)$`)
 var accountIDRegexp = regexache.MustCompile(`^(aws|aws-managed|third-party|\d{12}|cw.{10})$`)
+var awsPrincipalAccountIDRegexp = regexache.MustCompile(`^\d{12}$`)
 var partitionRegexp = regexache.MustCompile(`^aws(-[a-z]+)*$`)
 var regionRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
 
@@ -96,34 +121,188 @@ func ValidARNCheck(f ...ARNCheckFunc) schema.SchemaValidateFunc {
 			return ws, errors
 		}
 
-		if parsedARN.Partition == "" {
-			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: missing partition value", k, value))
-		} else if !partitionRegexp.MatchString(parsedARN.Partition) {
-			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid partition value (expecting to match regular expression: %s)", k, value, partitionRegexp))
+		for _, reason := range arnSanityCheck(parsedARN) {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, reason))
+		}
+
+		for _, f := range f {
+			w, e := f(v, k, parsedARN)
+			ws = append(ws, w...)
+			errors = append(errors, e...)
+		}
+
+		return ws, errors
+	}
+}
+
+// arnSanityCheck returns the reasons, if any, that the parsed ARN's partition, region, account ID
+// and resource values are invalid.
+func arnSanityCheck(a arn.ARN) []string {
+	var reasons []string
+
+	if a.Partition == "" {
+		reasons = append(reasons, "missing partition value")
+	} else if !partitionRegexp.MatchString(a.Partition) {
+		reasons = append(reasons, fmt.Sprintf("invalid partition value (expecting to match regular expression: %s)", partitionRegexp))
+	}
+
+	if a.Region != "" && !regionRegexp.MatchString(a.Region) {
+		reasons = append(reasons, fmt.Sprintf("invalid region value (expecting to match regular expression: %s)", regionRegexp))
+	}
+
+	if a.AccountID != "" && !accountIDRegexp.MatchString(a.AccountID) {
+		reasons = append(reasons, fmt.Sprintf("invalid account ID value (expecting to match regular expression: %s)", accountIDRegexp))
+	}
+
+	if a.Resource == "" {
+		reasons = append(reasons, "missing resource value")
+	}
+
+	return reasons
+}
+
+// InvalidARNError is returned by ParseARN when a string is not a valid ARN.
+type InvalidARNError struct {
+	ARN     string
+	Reasons []string
+}
+
+func (e *InvalidARNError) Error() string {
+	return fmt.Sprintf("%q is an invalid ARN: %s", e.ARN, strings.Join(e.Reasons, "; "))
+}
+
+// ParseARN parses a string as an ARN, applying the same checks as ValidARNCheck.
+// If the string is not a valid ARN, an *InvalidARNError is returned.
+func ParseARN(s string) (arn.ARN, error) {
+	parsedARN, err := arn.Parse(s)
+	if err != nil {
+		return arn.ARN{}, &InvalidARNError{ARN: s, Reasons: []string{err.Error()}}
+	}
+
+	if reasons := arnSanityCheck(parsedARN); len(reasons) > 0 {
+		return arn.ARN{}, &InvalidARNError{ARN: s, Reasons: reasons}
+	}
+
+	return parsedARN, nil
+}
+
+// ValidARNOrWildcard validates that a string value is either exactly "*" or a valid ARN.
+func ValidARNOrWildcard(v any, k string) (ws []string, errors []error) {
+	if value, ok := v.(string); ok && value == "*" {
+		return ws, errors
+	}
+
+	return ValidARN(v, k)
+}
+
+// ValidARNForService returns an ARNCheckFunc which tests that the ARN's service is the specified service.
+func ValidARNForService(service string) ARNCheckFunc {
+	return func(v any, k string, a arn.ARN) (ws []string, errors []error) {
+		if a.Service != service {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected service %q, got %q", k, v, service, a.Service))
 		}
 
-		if parsedARN.Region != "" && !regionRegexp.MatchString(parsedARN.Region) {
-			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid region value (expecting to match regular expression: %s)", k, value, regionRegexp))
+		return ws, errors
+	}
+}
+
+// ValidARNSameRegion returns an ARNCheckFunc which tests that the ARN's region is the specified region.
+// An empty region skips the check.
+func ValidARNSameRegion(region string) ARNCheckFunc {
+	return func(v any, k string, a arn.ARN) (ws []string, errors []error) {
+		if region != "" && a.Region != region {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected region %q, got %q", k, v, region, a.Region))
 		}
 
-		if parsedARN.AccountID != "" && !accountIDRegexp.MatchString(parsedARN.AccountID) {
-			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid account ID value (expecting to match regular expression: %s)", k, value, accountIDRegexp))
+		return ws, errors
+	}
+}
+
+// ValidARNAccountMatches returns an ARNCheckFunc which tests that the ARN's account ID is the specified
+// account ID, e.g. that of a resource referencing the ARN. An empty account ID, in either the ARN or
+// otherAccountID, skips the check.
+func ValidARNAccountMatches(otherAccountID string) ARNCheckFunc {
+	return func(v any, k string, a arn.ARN) (ws []string, errors []error) {
+		if otherAccountID != "" && a.AccountID != "" && a.AccountID != otherAccountID {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected account ID %q, got %q", k, v, otherAccountID, a.AccountID))
 		}
 
-		if parsedARN.Resource == "" {
-			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: missing resource value", k, value))
+		return ws, errors
+	}
+}
+
+// ValidARNResourceDelimiter returns an ARNCheckFunc which tests that the ARN's resource type and resource ID
+// are separated by the specified delimiter, ":" (e.g. "function:my-function") or "/" (e.g. "instance/i-12345678").
+// Resources with no delimiter, e.g. S3 bucket names, are accepted.
+func ValidARNResourceDelimiter(delim string) ARNCheckFunc {
+	return func(v any, k string, a arn.ARN) (ws []string, errors []error) {
+		if i := strings.IndexAny(a.Resource, ":/"); i != -1 && a.Resource[i:i+1] != delim {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected resource delimiter %q, got %q", k, v, delim, a.Resource[i:i+1]))
 		}
 
-		for _, f := range f {
-			w, e := f(v, k, parsedARN)
-			ws = append(ws, w...)
-			errors = append(errors, e...)
+		return ws, errors
+	}
+}
+
+// arnGlobalServices are the services whose ARNs have no region, e.g. "arn:aws:iam::123456789012:role/example".
+var arnGlobalServices = []string{
+	"cloudfront",
+	"globalaccelerator",
+	"iam",
+	"organizations",
+	"route53",
+	"s3",
+	"sts",
+	"waf",
+}
+
+// arnAccountlessServices are the services whose ARNs may have no account ID, e.g. "arn:aws:s3:::example".
+var arnAccountlessServices = []string{
+	"route53",
+	"s3",
+}
+
+// ValidARNFullyQualified validates that a string value is an ARN with a partition, region and account ID,
+// rejecting partially templated ARNs such as "arn:aws:sns:::example". The region is not required for global
+// services, e.g. IAM, and the account ID is not required for services such as S3 whose ARNs omit it.
+var ValidARNFullyQualified = ValidARNCheck(validARNFullyQualified)
+
+func validARNFullyQualified(v any, k string, a arn.ARN) (ws []string, errors []error) {
+	if a.Region == "" && !slices.Contains(arnGlobalServices, a.Service) {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: missing region value, required for service %q", k, v, a.Service))
+	}
+
+	if a.AccountID == "" && !slices.Contains(arnAccountlessServices, a.Service) {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: missing account ID value, required for service %q", k, v, a.Service))
+	}
+
+	return ws, errors
+}
+
+// ValidACMCertificateARN returns a SchemaValidateFunc which tests that a string value is an ACM ARN
+// in the required region, e.g. "us-east-1" for CloudFront. An empty required region allows any region.
+func ValidACMCertificateARN(requiredRegion string) schema.SchemaValidateFunc {
+	return ValidARNCheck(ValidARNForService("acm"), ValidARNSameRegion(requiredRegion))
+}
+
+// validARNResourceType returns an ARNCheckFunc which tests that the ARN's resource is of the specified type,
+// e.g. "endpoint" for "endpoint:ABCDEFGHIJKLMNOPQRSTUVWXYZ".
+func validARNResourceType(resourceType string) ARNCheckFunc {
+	return func(v any, k string, a arn.ARN) (ws []string, errors []error) {
+		if typ, _, _ := strings.Cut(a.Resource, ":"); typ != resourceType {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: expected resource type %q, got %q", k, v, resourceType, typ))
 		}
 
 		return ws, errors
 	}
 }
 
+// ValidDMSEndpointARN validates that a string value is a DMS endpoint ARN.
+var ValidDMSEndpointARN = ValidARNCheck(ValidARNForService("dms"), validARNResourceType("endpoint"))
+
+// ValidDMSReplicationInstanceARN validates that a string value is a DMS replication instance ARN.
+var ValidDMSReplicationInstanceARN = ValidARNCheck(ValidARNForService("dms"), validARNResourceType("rep"))
+
 func ValidAccountID(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -191,6 +370,248 @@ func ValidIAMPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
 	return //nolint:nakedret // Just a long function.
 }
 
+// iamPolicyStatements returns the statements of an IAM policy document.
+// The policy's Statement element may be either a single statement object or an array of statements.
+func iamPolicyStatements(policy string) ([]map[string]any, error) {
+	var document struct {
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return nil, err
+	}
+
+	if len(document.Statement) == 0 {
+		return nil, nil
+	}
+
+	var statements []map[string]any
+	if err := json.Unmarshal(document.Statement, &statements); err == nil {
+		return statements, nil
+	}
+
+	var statement map[string]any
+	if err := json.Unmarshal(document.Statement, &statement); err != nil {
+		return nil, fmt.Errorf("Statement must be an object or an array of objects")
+	}
+
+	return []map[string]any{statement}, nil
+}
+
+// iamPolicyStringValues returns the string values of an IAM policy element that may be
+// either a single string or an array of strings.
+func iamPolicyStringValues(v any) ([]string, bool) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, true
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, v := range v {
+			s, ok := v.(string)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	default:
+		return nil, false
+	}
+}
+
+// ValidIAMPolicyPrincipals validates that each statement's Principal and NotPrincipal elements
+// use only recognized principal types and that AWS principals are account IDs, ARNs or "*".
+func ValidIAMPolicyPrincipals(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		return
+	}
+
+	statements, err := iamPolicyStatements(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON policy: %s", k, err))
+		return
+	}
+
+	for i, statement := range statements {
+		for _, element := range []string{"Principal", "NotPrincipal"} {
+			principal, ok := statement[element]
+			if !ok {
+				continue
+			}
+
+			if principal == "*" {
+				continue
+			}
+
+			principals, ok := principal.(map[string]any)
+			if !ok {
+				errors = append(errors, fmt.Errorf("%q: statement %d: %s must be \"*\" or an object", k, i, element))
+				continue
+			}
+
+			principalTypes := maps.Keys(principals)
+			slices.Sort(principalTypes)
+
+			for _, typ := range principalTypes {
+				values, ok := iamPolicyStringValues(principals[typ])
+				if !ok {
+					errors = append(errors, fmt.Errorf("%q: statement %d: %s %q must be a string or an array of strings", k, i, element, typ))
+					continue
+				}
+
+				switch typ {
+				case "AWS":
+					for _, v := range values {
+						if v == "*" || awsPrincipalAccountIDRegexp.MatchString(v) {
+							continue
+						}
+						if _, errs := ValidARN(v, k); v == "" || len(errs) > 0 {
+							errors = append(errors, fmt.Errorf("%q: statement %d: %s AWS value %q must be an account ID, an ARN or \"*\"", k, i, element, v))
+						}
+					}
+				case "Service", "Federated", "CanonicalUser":
+				default:
+					errors = append(errors, fmt.Errorf("%q: statement %d: %s has unrecognized principal type %q (expected one of AWS, Service, Federated, CanonicalUser)", k, i, element, typ))
+				}
+			}
+		}
+	}
+
+	return //nolint:nakedret // Just a long function.
+}
+
+// iamPolicyConditionOperators are the IAM policy condition operators, without the "IfExists" suffix
+// and the "ForAllValues:" and "ForAnyValue:" set operator prefixes.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html.
+var iamPolicyConditionOperators = []string{
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateNotEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"Null",
+	"NumericEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericNotEquals",
+	"StringEquals",
+	"StringEqualsIgnoreCase",
+	"StringLike",
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+}
+
+// ValidIAMPolicyConditionOperators validates that each statement's Condition element uses only
+// recognized condition operators, optionally with the "IfExists" suffix (except "Null") and
+// the "ForAllValues:" or "ForAnyValue:" prefix.
+func ValidIAMPolicyConditionOperators(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		return
+	}
+
+	statements, err := iamPolicyStatements(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON policy: %s", k, err))
+		return
+	}
+
+	for i, statement := range statements {
+		condition, ok := statement["Condition"]
+		if !ok {
+			continue
+		}
+
+		operators, ok := condition.(map[string]any)
+		if !ok {
+			errors = append(errors, fmt.Errorf("%q: statement %d: Condition must be an object", k, i))
+			continue
+		}
+
+		keys := maps.Keys(operators)
+		slices.Sort(keys)
+
+		for _, operator := range keys {
+			name := operator
+			for _, prefix := range []string{"ForAllValues:", "ForAnyValue:"} {
+				name = strings.TrimPrefix(name, prefix)
+			}
+			if name != "NullIfExists" {
+				name = strings.TrimSuffix(name, "IfExists")
+			}
+
+			if slices.Contains(iamPolicyConditionOperators, name) {
+				continue
+			}
+
+			if match, ok := closestMatch(name, iamPolicyConditionOperators); ok {
+				errors = append(errors, fmt.Errorf("%q: statement %d: unknown condition operator %q; did you mean %q?", k, i, operator, match))
+			} else {
+				errors = append(errors, fmt.Errorf("%q: statement %d: unknown condition operator %q", k, i, operator))
+			}
+		}
+	}
+
+	return
+}
+
+// ValidIAMPolicyUniqueSids validates that the non-empty Sid (statement ID) values of a policy's
+// statements are unique. Statements without a Sid are ignored.
+func ValidIAMPolicyUniqueSids(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		return
+	}
+
+	statements, err := iamPolicyStatements(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON policy: %s", k, err))
+		return
+	}
+
+	indices := make(map[string]int)
+
+	for i, statement := range statements {
+		v, ok := statement["Sid"]
+		if !ok {
+			continue
+		}
+
+		sid, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("%q: statement %d: Sid must be a string", k, i))
+			continue
+		}
+		if sid == "" {
+			continue
+		}
+
+		if j, ok := indices[sid]; ok {
+			errors = append(errors, fmt.Errorf("%q: statement %d: Sid %q is already used by statement %d; Sids must be unique", k, i, sid, j))
+			continue
+		}
+		indices[sid] = i
+	}
+
+	return
+}
+
 // ValidateIPv4CIDRBlock validates that the specified CIDR block is valid:
 // - The CIDR block parses to an IP address and network
 // - The IP address is an IPv4 address
@@ -257,87 +678,303 @@ func ValidIPv6CIDRNetworkAddress(v interface{}, k string) (ws []string, errors [
 	return
 }
 
-// IsIPv4CIDRBlockOrIPv6CIDRBlock returns a SchemaValidateFunc that test if the provided value:
-// - Is a valid IPv4 CIDR block and passes the specified validation, or
-// - Is a valid IPv6 CIDR block and passes the specified validation
-func IsIPv4CIDRBlockOrIPv6CIDRBlock(ipv4Validator, ipv6Validator schema.SchemaValidateFunc) schema.SchemaValidateFunc {
-	return validation.Any(
-		validation.All(ValidIPv4CIDRNetworkAddress, ipv4Validator),
-		validation.All(ValidIPv6CIDRNetworkAddress, ipv6Validator),
-	)
-}
-
-// KMS Key IDs (a subset of KMS Key Identifiers) can be be key ID, key ARN, alias name, or alias ARN.
-// There's no guarantee about the format of a Key ID other than a string between 1 and 2048 characters
-// (per KMS API documentation and internal AWS conversations).
-// ref: https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#key-id
-// ref: https://docs.aws.amazon.com/kms/latest/APIReference/API_Encrypt.html#KMS-Encrypt-request-KeyId
-func ValidKMSKeyID(v interface{}, k string) (ws []string, errors []error) {
+// ValidVPCCIDRBlock ensures that the string value is a valid IPv4 CIDR block
+// with a prefix length allowed for a VPC (between /16 and /28 inclusive).
+func ValidVPCCIDRBlock(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
-	if len(value) < 1 {
-		errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
-	} else if len(value) > 2048 {
-		errors = append(errors, fmt.Errorf("%q cannot be longer than 2048 characters", k))
+
+	if err := ValidateIPv4CIDRBlock(value); err != nil {
+		errors = append(errors, err)
+		return
 	}
-	return
-}
 
-func ValidLaunchTemplateID(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
-	if len(value) < 1 {
-		errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
-	} else if len(value) > 255 {
-		errors = append(errors, fmt.Errorf("%q cannot be longer than 255 characters", k))
-	} else if !regexache.MustCompile(`^lt\-[0-9a-z]+$`).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"%q must begin with 'lt-' and be comprised of only alphanumeric characters: %v", k, value))
+	_, ipnet, _ := net.ParseCIDR(value)
+	if ones, _ := ipnet.Mask.Size(); ones < 16 || ones > 28 {
+		errors = append(errors, fmt.Errorf("%q (%s) has a prefix length of /%d; VPC CIDR blocks must be between /16 and /28", k, value, ones))
 	}
+
 	return
 }
 
-func ValidLaunchTemplateName(v interface{}, k string) (ws []string, errors []error) {
+// ValidVPCIPv6CIDRBlock ensures that the string value is a valid IPv6 CIDR block
+// with the /56 prefix length required for Amazon-provided VPC IPv6 CIDR blocks.
+func ValidVPCIPv6CIDRBlock(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
-	if len(value) < 3 {
-		errors = append(errors, fmt.Errorf("%q cannot be less than 3 characters", k))
-	} else if strings.HasSuffix(k, "prefix") && len(value) > 99 {
-		errors = append(errors, fmt.Errorf("%q cannot be longer than 99 characters, name is limited to 125", k))
-	} else if !strings.HasSuffix(k, "prefix") && len(value) > 125 {
-		errors = append(errors, fmt.Errorf("%q cannot be longer than 125 characters", k))
-	} else if !regexache.MustCompile(`^[0-9A-Za-z()./_\-]+$`).MatchString(value) {
-		errors = append(errors, fmt.Errorf("%q can only alphanumeric characters and ()./_- symbols", k))
-	}
-	return
-}
 
-// validateMulticastIPAddress validates that the specified string is a multicast IP address.
-func validateMulticastIPAddress(s string) error {
-	ip := net.ParseIP(s)
-	if ip == nil {
-		return fmt.Errorf("%q is not a valid IP address", s)
+	if err := ValidateIPv6CIDRBlock(value); err != nil {
+		errors = append(errors, err)
+		return
 	}
 
-	if !ip.IsMulticast() {
-		return fmt.Errorf("%q is not a valid multicast address", s)
+	_, ipnet, _ := net.ParseCIDR(value)
+	if ones, _ := ipnet.Mask.Size(); ones != 56 {
+		errors = append(errors, fmt.Errorf("%q (%s) has a prefix length of /%d; VPC IPv6 CIDR blocks must be /56", k, value, ones))
 	}
 
-	return nil
+	return
 }
 
-func ValidMulticastIPAddress(v interface{}, k string) (ws []string, errors []error) {
-	if err := validateMulticastIPAddress(v.(string)); err != nil {
-		errors = append(errors, err)
-		return
-	}
+// IPv4CIDRCanHostSubnets returns a SchemaValidateFunc which tests that a string value is an IPv4 CIDR block
+// that can be divided into at least count subnets, each subnetBits longer in prefix length than the block.
+// For example, IPv4CIDRCanHostSubnets(8, 256) accepts a /16 block which can hold 256 /24 subnets.
+// A negative subnetBits is reported as an error.
+func IPv4CIDRCanHostSubnets(subnetBits, count int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
 
-	return
-}
+		if subnetBits < 0 {
+			errors = append(errors, fmt.Errorf("%q: subnet prefix length increase (%d) must not be negative", k, subnetBits))
+			return
+		}
 
-func ValidOnceADayWindowFormat(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
+		if err := ValidateIPv4CIDRBlock(value); err != nil {
+			errors = append(errors, err)
+			return
+		}
+
+		_, ipnet, _ := net.ParseCIDR(value)
+		ones, bits := ipnet.Mask.Size()
+
+		if ones+subnetBits > bits {
+			errors = append(errors, fmt.Errorf("%q (%s) cannot be divided into /%d subnets", k, value, ones+subnetBits))
+			return
+		}
+
+		if capacity := uint64(1) << subnetBits; capacity < uint64(count) {
+			errors = append(errors, fmt.Errorf("%q (%s) can hold %d /%d subnets, at least %d are required", k, value, capacity, ones+subnetBits, count))
+		}
 
-	t := timestamp.New(value)
-	if err := t.ValidateOnceADayWindowFormat(); err != nil {
-		errors = append(errors, err)
+		return
+	}
+}
+
+// awsSubnetReservedAddresses is the number of IP addresses AWS reserves in each subnet:
+// the network address, the VPC router, DNS, future use and the broadcast address.
+// See https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html.
+const awsSubnetReservedAddresses = 5
+
+// IPv4SubnetUsableCapacity returns the number of IP addresses available for use in a subnet with the
+// specified IPv4 CIDR block, after AWS reservations. The smallest subnet AWS allows is a /28.
+func IPv4SubnetUsableCapacity(cidr string) (int, error) {
+	if err := ValidateIPv4CIDRBlock(cidr); err != nil {
+		return 0, err
+	}
+
+	_, ipnet, _ := net.ParseCIDR(cidr)
+	ones, bits := ipnet.Mask.Size()
+
+	if ones > 28 {
+		return 0, fmt.Errorf("%q is smaller than the minimum subnet size, /28", cidr)
+	}
+
+	return 1<<(bits-ones) - awsSubnetReservedAddresses, nil
+}
+
+// ValidSubnetMinUsable returns a SchemaValidateFunc which tests that a string value is an IPv4 CIDR block
+// for a subnet with at least min IP addresses available for use after AWS reservations.
+func ValidSubnetMinUsable(min int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+
+		usable, err := IPv4SubnetUsableCapacity(value)
+		if err != nil {
+			errors = append(errors, err)
+			return
+		}
+
+		if usable < min {
+			errors = append(errors, fmt.Errorf("%q (%s) has %d usable IP addresses, at least %d are required", k, value, usable, min))
+		}
+
+		return
+	}
+}
+
+// cidrBlockRange is the inclusive range of addresses in a CIDR block.
+type cidrBlockRange struct {
+	cidr        string
+	bits        int
+	first, last *big.Int
+}
+
+func newCIDRBlockRange(cidr string) (*cidrBlockRange, error) {
+	if err := types.ValidateCIDRBlock(cidr); err != nil {
+		return nil, err
+	}
+
+	_, ipnet, _ := net.ParseCIDR(cidr)
+	ip := ipnet.IP
+	if v := ip.To4(); v != nil {
+		ip = v
+	}
+	ones, bits := ipnet.Mask.Size()
+
+	first := new(big.Int).SetBytes(ip)
+	last := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+	last.Add(last, first).Sub(last, big.NewInt(1))
+
+	return &cidrBlockRange{cidr: cidr, bits: bits, first: first, last: last}, nil
+}
+
+// rangeCIDRBlocks returns the smallest list of CIDR blocks exactly covering the inclusive range of addresses.
+func rangeCIDRBlocks(first, last *big.Int, bits int) []string {
+	var cidrs []string
+	one := big.NewInt(1)
+
+	for first = new(big.Int).Set(first); first.Cmp(last) <= 0; {
+		n := bits
+		if first.Sign() != 0 {
+			n = int(first.TrailingZeroBits())
+		}
+
+		size := new(big.Int)
+		for ; ; n-- {
+			size.Lsh(one, uint(n))
+			if end := new(big.Int).Add(first, size); end.Sub(end, one).Cmp(last) <= 0 {
+				break
+			}
+		}
+
+		ip := net.IP(first.FillBytes(make([]byte, bits/8)))
+		cidrs = append(cidrs, fmt.Sprintf("%s/%d", ip, bits-n))
+		first.Add(first, size)
+	}
+
+	return cidrs
+}
+
+// CIDRBlocksCoverParent returns whether the child CIDR blocks exactly cover the parent CIDR block
+// and, if they do not, the CIDR blocks within the parent that no child covers.
+// An error is returned if any CIDR block is invalid, if a child is not within the parent,
+// or if any two children overlap.
+func CIDRBlocksCoverParent(parent string, children []string) (bool, []string, error) {
+	p, err := newCIDRBlockRange(parent)
+	if err != nil {
+		return false, nil, err
+	}
+
+	ranges := make([]*cidrBlockRange, 0, len(children))
+
+	for _, child := range children {
+		c, err := newCIDRBlockRange(child)
+		if err != nil {
+			return false, nil, err
+		}
+
+		if c.bits != p.bits || c.first.Cmp(p.first) < 0 || c.last.Cmp(p.last) > 0 {
+			return false, nil, fmt.Errorf("%q is not within %q", child, parent)
+		}
+
+		ranges = append(ranges, c)
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].first.Cmp(ranges[j].first) < 0
+	})
+
+	var uncovered []string
+	next := new(big.Int).Set(p.first)
+
+	for i, c := range ranges {
+		if i > 0 && c.first.Cmp(ranges[i-1].last) <= 0 {
+			return false, nil, fmt.Errorf("%q overlaps %q", c.cidr, ranges[i-1].cidr)
+		}
+
+		if c.first.Cmp(next) > 0 {
+			uncovered = append(uncovered, rangeCIDRBlocks(next, new(big.Int).Sub(c.first, big.NewInt(1)), p.bits)...)
+		}
+
+		next.Add(c.last, big.NewInt(1))
+	}
+
+	if next.Cmp(p.last) <= 0 {
+		uncovered = append(uncovered, rangeCIDRBlocks(next, p.last, p.bits)...)
+	}
+
+	return len(uncovered) == 0, uncovered, nil
+}
+
+// IsIPv4CIDRBlockOrIPv6CIDRBlock returns a SchemaValidateFunc that test if the provided value:
+// - Is a valid IPv4 CIDR block and passes the specified validation, or
+// - Is a valid IPv6 CIDR block and passes the specified validation
+func IsIPv4CIDRBlockOrIPv6CIDRBlock(ipv4Validator, ipv6Validator schema.SchemaValidateFunc) schema.SchemaValidateFunc {
+	return validation.Any(
+		validation.All(ValidIPv4CIDRNetworkAddress, ipv4Validator),
+		validation.All(ValidIPv6CIDRNetworkAddress, ipv6Validator),
+	)
+}
+
+// KMS Key IDs (a subset of KMS Key Identifiers) can be be key ID, key ARN, alias name, or alias ARN.
+// There's no guarantee about the format of a Key ID other than a string between 1 and 2048 characters
+// (per KMS API documentation and internal AWS conversations).
+// ref: https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#key-id
+// ref: https://docs.aws.amazon.com/kms/latest/APIReference/API_Encrypt.html#KMS-Encrypt-request-KeyId
+func ValidKMSKeyID(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 {
+		errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+	} else if len(value) > 2048 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 2048 characters", k))
+	}
+	return
+}
+
+func ValidLaunchTemplateID(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 {
+		errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+	} else if len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 255 characters", k))
+	} else if !regexache.MustCompile(`^lt\-[0-9a-z]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must begin with 'lt-' and be comprised of only alphanumeric characters: %v", k, value))
+	}
+	return
+}
+
+func ValidLaunchTemplateName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 3 {
+		errors = append(errors, fmt.Errorf("%q cannot be less than 3 characters", k))
+	} else if strings.HasSuffix(k, "prefix") && len(value) > 99 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 99 characters, name is limited to 125", k))
+	} else if !strings.HasSuffix(k, "prefix") && len(value) > 125 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 125 characters", k))
+	} else if !regexache.MustCompile(`^[0-9A-Za-z()./_\-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q can only alphanumeric characters and ()./_- symbols", k))
+	}
+	return
+}
+
+// validateMulticastIPAddress validates that the specified string is a multicast IP address.
+func validateMulticastIPAddress(s string) error {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return fmt.Errorf("%q is not a valid IP address", s)
+	}
+
+	if !ip.IsMulticast() {
+		return fmt.Errorf("%q is not a valid multicast address", s)
+	}
+
+	return nil
+}
+
+func ValidMulticastIPAddress(v interface{}, k string) (ws []string, errors []error) {
+	if err := validateMulticastIPAddress(v.(string)); err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	return
+}
+
+func ValidOnceADayWindowFormat(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	t := timestamp.New(value)
+	if err := t.ValidateOnceADayWindowFormat(); err != nil {
+		errors = append(errors, err)
 		return
 	}
 
@@ -384,6 +1021,117 @@ func ValidStringIsJSONOrYAML(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+// ValidSingleYAMLDocument validates that a string value is a YAML stream containing exactly one
+// non-empty document, i.e. that it is valid YAML and has no "---" separated additional documents.
+// Empty documents, such as the one following a trailing "---", are ignored.
+func ValidSingleYAMLDocument(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	dec := yaml.NewDecoder(strings.NewReader(value))
+
+	n := 0
+	for {
+		var y any
+		if err := dec.Decode(&y); err == io.EOF {
+			break
+		} else if err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid YAML: %s", k, err))
+			return
+		}
+		if y != nil {
+			n++
+		}
+	}
+
+	switch {
+	case n == 0:
+		errors = append(errors, fmt.Errorf("%q must contain a YAML document", k))
+	case n > 1:
+		errors = append(errors, fmt.Errorf("%q must contain a single YAML document, found %d", k, n))
+	}
+
+	return
+}
+
+// ValidStrictJSON validates that a string value is a single JSON value with no trailing data,
+// rejecting concatenated documents such as `{} {}` or `{} extra`.
+func ValidStrictJSON(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	dec := json.NewDecoder(strings.NewReader(value))
+
+	var j any
+	if err := dec.Decode(&j); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
+	}
+
+	if _, err := dec.Token(); dec.More() || err != io.EOF {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: unexpected data after the first JSON value at byte offset %d", k, dec.InputOffset()))
+	}
+
+	return
+}
+
+// ValidJSONMaxSize returns a SchemaValidateFunc which tests that a string value is valid JSON
+// that is no more than maxBytes bytes long, e.g. for CloudWatch dashboard bodies.
+// The size is measured on the raw value, including whitespace.
+func ValidJSONMaxSize(maxBytes int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if _, err := structure.NormalizeJsonString(value); err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+			return
+		}
+
+		if n := len(value); n > maxBytes {
+			errors = append(errors, fmt.Errorf("%q is %d bytes long, the maximum is %d bytes", k, n, maxBytes))
+		}
+
+		return
+	}
+}
+
+// ValidJSONArrayUniqueElements validates that a string value is a JSON array whose elements are all distinct.
+// Elements are compared by deep equality, so objects with the same members in a different order are duplicates.
+func ValidJSONArrayUniqueElements(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	var elements []any
+	if err := json.Unmarshal([]byte(value), &elements); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON array: %s", k, err))
+		return
+	}
+
+	for i := range elements {
+		for j := 0; j < i; j++ {
+			if reflect.DeepEqual(elements[i], elements[j]) {
+				errors = append(errors, fmt.Errorf("%q: element %d is a duplicate of element %d", k, i, j))
+				break
+			}
+		}
+	}
+
+	return
+}
+
 // ValidTypeStringNullableFloat provides custom error messaging for TypeString floats
 // Some arguments require a floating point value or an unspecified, empty field.
 func ValidTypeStringNullableFloat(v interface{}, k string) (ws []string, es []error) {
@@ -404,6 +1152,38 @@ func ValidTypeStringNullableFloat(v interface{}, k string) (ws []string, es []er
 	return
 }
 
+// spotPriceMaxDecimalPlaces is the precision of EC2 Spot prices, e.g. "0.031200".
+const spotPriceMaxDecimalPlaces = 6
+
+var spotPriceRegexp = regexache.MustCompile(`^\d+(\.\d+)?$`)
+
+// ValidSpotPrice validates that a string value is an EC2 Spot price: a positive decimal number of US dollars,
+// e.g. "0.05", with at most 6 decimal places. An empty value, meaning the On-Demand price, is allowed.
+func ValidSpotPrice(v interface{}, k string) (ws []string, es []error) {
+	ws, es = ValidTypeStringNullableFloat(v, k)
+	if len(es) > 0 {
+		return
+	}
+
+	value := v.(string)
+	if value == "" {
+		return
+	}
+
+	// strconv.ParseFloat also accepts forms such as "NaN", "Inf", "1e-3" and "0x1p-2".
+	if f, _ := strconv.ParseFloat(value, 64); math.IsNaN(f) || math.IsInf(f, 0) {
+		es = append(es, fmt.Errorf("%s: spot price (%s) must be a finite number", k, value))
+	} else if f <= 0 {
+		es = append(es, fmt.Errorf("%s: spot price (%s) must be greater than 0", k, value))
+	} else if !spotPriceRegexp.MatchString(value) {
+		es = append(es, fmt.Errorf("%s: spot price (%s) must be a decimal number, e.g. \"0.05\"", k, value))
+	} else if _, fraction, _ := strings.Cut(value, "."); len(fraction) > spotPriceMaxDecimalPlaces {
+		es = append(es, fmt.Errorf("%s: spot price (%s) cannot have more than %d decimal places", k, value, spotPriceMaxDecimalPlaces))
+	}
+
+	return
+}
+
 // ValidUTCTimestamp validates a string in UTC Format required by APIs including:
 // https://docs.aws.amazon.com/iot/latest/apireference/API_CloudwatchMetricAction.html
 // https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_RestoreDBInstanceToPointInTime.html
@@ -419,6 +1199,62 @@ func ValidUTCTimestamp(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// ValidUTCTimestampInFuture validates that a string is an RFC3339 timestamp later than the current time.
+var ValidUTCTimestampInFuture = ValidUTCTimestampFuture(0)
+
+// ValidUTCTimestampInPast validates that a string is an RFC3339 timestamp earlier than the current time.
+var ValidUTCTimestampInPast = ValidUTCTimestampPast(0)
+
+// ValidUTCTimestampFuture returns a SchemaValidateFunc which tests that a string is an RFC3339 timestamp
+// later than the current time. Timestamps up to skew in the past are tolerated.
+func ValidUTCTimestampFuture(skew time.Duration) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		t, err := parseUTCTimestamp(v, k)
+		if err != nil {
+			errors = append(errors, err)
+			return
+		}
+
+		if now := clock().UTC(); !t.After(now.Add(-skew)) {
+			errors = append(errors, fmt.Errorf("%q (%s) must be in the future (current time: %s)", k, v, now.Format(time.RFC3339)))
+		}
+
+		return
+	}
+}
+
+// ValidUTCTimestampPast returns a SchemaValidateFunc which tests that a string is an RFC3339 timestamp
+// earlier than the current time. Timestamps up to skew in the future are tolerated.
+func ValidUTCTimestampPast(skew time.Duration) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		t, err := parseUTCTimestamp(v, k)
+		if err != nil {
+			errors = append(errors, err)
+			return
+		}
+
+		if now := clock().UTC(); !t.Before(now.Add(skew)) {
+			errors = append(errors, fmt.Errorf("%q (%s) must be in the past (current time: %s)", k, v, now.Format(time.RFC3339)))
+		}
+
+		return
+	}
+}
+
+func parseUTCTimestamp(v interface{}, k string) (time.Time, error) {
+	value, ok := v.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("expected type of %s to be string", k)
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q (%s) must be in RFC3339 time format %q: %s", k, value, time.RFC3339, err)
+	}
+
+	return t.UTC(), nil
+}
+
 var ValidStringDateOrPositiveInt = validation.Any(
 	validation.IsRFC3339Time,
 	validation.StringMatch(regexache.MustCompile(`^\d+$`), "must be a positive integer value"),
@@ -472,3 +1308,2206 @@ func ValidServicePrincipal(v interface{}, k string) (ws []string, errors []error
 func IsServicePrincipal(value string) (valid bool) {
 	return servicePrincipalRegexp.MatchString(value)
 }
+
+// MapKeysNoReservedPrefix returns a SchemaValidateDiagFunc which tests that none of
+// the keys of the provided map value begin with the specified prefix, e.g. "aws:" for tags.
+// An error is reported for each offending key.
+func MapKeysNoReservedPrefix(prefix string) schema.SchemaValidateDiagFunc {
+	return func(v any, path cty.Path) diag.Diagnostics {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return diag.Diagnostics{errs.NewIncorrectValueTypeAttributeError(path, "map")}
+		}
+
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var diags diag.Diagnostics
+
+		for _, k := range keys {
+			if strings.HasPrefix(k, prefix) {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.IndexString(k), "Key %q must not begin with reserved prefix %q", k, prefix))
+			}
+		}
+
+		return diags
+	}
+}
+
+// MapMaxKeys returns a SchemaValidateDiagFunc which tests that the provided map value
+// has at most max keys.
+func MapMaxKeys(max int) schema.SchemaValidateDiagFunc {
+	return func(v any, path cty.Path) diag.Diagnostics {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return diag.Diagnostics{errs.NewIncorrectValueTypeAttributeError(path, "map")}
+		}
+
+		if n := len(m); n > max {
+			return diag.Diagnostics{errs.NewInvalidValueAttributeErrorf(path, "Expected at most %d keys, got %d", max, n)}
+		}
+
+		return nil
+	}
+}
+
+// TagMapWithinLimit tests that a tag map value has at most 50 tags, the limit for most AWS resources.
+var TagMapWithinLimit = MapMaxKeys(50)
+
+var dottedQuadRegexp = regexache.MustCompile(`^\d{1,3}(\.\d{1,3}){3}$`)
+
+// StringIsNotIPv4 validates that a string value is not formatted as a dotted-quad IPv4 address,
+// including addresses with leading zeros or out-of-range octets such as "01.2.3.4" or "256.1.1.1".
+// DNS-compliant names such as S3 bucket names must not look like IP addresses.
+func StringIsNotIPv4(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if dottedQuadRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) must not be formatted as an IP address; DNS-compliant names cannot look like IPv4 addresses", k, value))
+	}
+
+	return
+}
+
+type scheduleExpression struct {
+	rateValue int
+	rateUnit  string
+	cron      []string // minutes, hours, day-of-month, month, day-of-week, year
+}
+
+// parseScheduleExpression parses a "rate(value unit)" or "cron(fields)" schedule expression.
+// See https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html.
+func parseScheduleExpression(s string) (*scheduleExpression, error) {
+	if m := rateExpressionRegexp.FindStringSubmatch(s); m != nil {
+		value, err := strconv.Atoi(m[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate value: %w", err)
+		}
+
+		unit := m[2]
+		if value <= 0 {
+			return nil, fmt.Errorf("rate value must be a positive integer")
+		}
+		if singular := value == 1; singular == strings.HasSuffix(unit, "s") {
+			if singular {
+				return nil, fmt.Errorf("rate unit must be singular (%s) for a value of 1", strings.TrimSuffix(unit, "s"))
+			}
+			return nil, fmt.Errorf("rate unit must be plural (%ss) for a value greater than 1", unit)
+		}
+
+		return &scheduleExpression{rateValue: value, rateUnit: strings.TrimSuffix(unit, "s")}, nil
+	}
+
+	if m := cronExpressionRegexp.FindStringSubmatch(s); m != nil {
+		fields := strings.Fields(m[1])
+		if n := len(fields); n != 6 {
+			return nil, fmt.Errorf("cron expression must have 6 fields (minutes hours day-of-month month day-of-week year), got %d", n)
+		}
+
+		return &scheduleExpression{cron: fields}, nil
+	}
+
+	return nil, fmt.Errorf("must be a rate(value unit) or cron(fields) expression")
+}
+
+// ValidScheduleExpression validates that a string value is a rate or cron schedule expression.
+func ValidScheduleExpression(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := parseScheduleExpression(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid schedule expression: %s", k, value, err))
+	}
+
+	return
+}
+
+// ValidRotationScheduleExpression validates a Secrets Manager rotation schedule expression.
+// In addition to the ValidScheduleExpression checks, the schedule must not run more than once a day:
+// rate expressions must be at least 24 hours and cron expressions must specify a single minute and hour.
+func ValidRotationScheduleExpression(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	expr, err := parseScheduleExpression(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid schedule expression: %s", k, value, err))
+		return
+	}
+
+	if (expr.rateUnit == "minute" && expr.rateValue < 24*60) || (expr.rateUnit == "hour" && expr.rateValue < 24) {
+		errors = append(errors, fmt.Errorf("%q (%s) runs more than once a day: rotation schedule rates must be at least 24 hours", k, value))
+	}
+
+	if expr.cron != nil {
+		// Only a single minute and hour value means at most one rotation per day.
+		if !isCronSingleValue(expr.cron[0]) || !isCronSingleValue(expr.cron[1]) {
+			errors = append(errors, fmt.Errorf("%q (%s) runs more than once a day: rotation schedules must specify a single value for the minutes and hours fields", k, value))
+		}
+	}
+
+	return
+}
+
+func isCronSingleValue(field string) bool {
+	_, err := strconv.Atoi(field)
+	return err == nil
+}
+
+// cronField describes the values allowed in a field of an AWS cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string // Names for the values min, min+1, ...
+	question bool     // Whether "?" is allowed.
+}
+
+var cronFields = []cronField{
+	{name: "minutes", min: 0, max: 59},
+	{name: "hours", min: 0, max: 23},
+	{name: "day-of-month", min: 1, max: 31, question: true},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day-of-week", min: 1, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}, question: true},
+	{name: "year", min: 1970, max: 2199},
+}
+
+// value parses a single value of the field.
+func (f cronField) value(s string) (int, error) {
+	if i := slices.Index(f.names, strings.ToUpper(s)); i != -1 {
+		return f.min + i, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%s field value %q is not a number", f.name, s)
+	}
+
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s field value (%d) must be between %d and %d", f.name, v, f.min, f.max)
+	}
+
+	return v, nil
+}
+
+// validate validates the field, a comma-separated list of values, ranges ("a-b"), increments ("a/n")
+// and the field-specific wildcards.
+func (f cronField) validate(s string) error {
+	if s == "?" {
+		if !f.question {
+			return fmt.Errorf("%s field must not be \"?\"", f.name)
+		}
+		return nil
+	}
+
+	for _, item := range strings.Split(s, ",") {
+		base, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			if n, err := strconv.Atoi(step); err != nil || n < 1 {
+				return fmt.Errorf("%s field increment %q must be a positive number", f.name, step)
+			}
+		}
+
+		switch {
+		case base == "*":
+			continue
+		case f.name == "day-of-month" && !hasStep && (base == "L" || base == "LW"):
+			continue
+		case f.name == "day-of-month" && !hasStep && strings.HasSuffix(base, "W"):
+			base = strings.TrimSuffix(base, "W")
+		case f.name == "day-of-week" && !hasStep && base == "L":
+			continue
+		case f.name == "day-of-week" && !hasStep && strings.HasSuffix(base, "L"):
+			base = strings.TrimSuffix(base, "L")
+		case f.name == "day-of-week" && !hasStep && strings.Contains(base, "#"):
+			var nth string
+			base, nth, _ = strings.Cut(base, "#")
+			if n, err := strconv.Atoi(nth); err != nil || n < 1 || n > 5 {
+				return fmt.Errorf("%s field occurrence %q must be between 1 and 5", f.name, nth)
+			}
+		}
+
+		from, to, isRange := strings.Cut(base, "-")
+		if _, err := f.value(from); err != nil {
+			return err
+		}
+
+		if isRange {
+			if _, err := f.value(to); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidAWSCronExpression validates that a string value is a "cron(fields)" schedule expression
+// whose six fields are each within AWS's allowed ranges, and in which exactly one of the day-of-month
+// and day-of-week fields is "?".
+// See https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html#eb-cron-expressions.
+func ValidAWSCronExpression(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	expr, err := parseScheduleExpression(value)
+	if err == nil && expr.cron == nil {
+		err = fmt.Errorf("must be a cron(fields) expression")
+	}
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid cron expression: %s", k, value, err))
+		return
+	}
+
+	for i, field := range cronFields {
+		if err := field.validate(expr.cron[i]); err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid cron expression: %s", k, value, err))
+		}
+	}
+
+	if dayOfMonth, dayOfWeek := expr.cron[2], expr.cron[4]; (dayOfMonth == "?") == (dayOfWeek == "?") {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid cron expression: exactly one of the day-of-month (%s) and day-of-week (%s) fields must be \"?\"", k, value, dayOfMonth, dayOfWeek))
+	}
+
+	return
+}
+
+// ValidGlueCatalogTableName validates that a string value is a valid Glue Data Catalog table name.
+// See https://docs.aws.amazon.com/athena/latest/ug/tables-databases-columns-names.html.
+var ValidGlueCatalogTableName = validLowercaseUnderscoreName("Glue Catalog table", 255)
+
+// ValidAthenaDatabaseName validates that a string value is a valid Athena database name.
+// See https://docs.aws.amazon.com/athena/latest/ug/tables-databases-columns-names.html.
+var ValidAthenaDatabaseName = validLowercaseUnderscoreName("Athena database", 255)
+
+var lowercaseUnderscoreNameRegexp = regexache.MustCompile(`^[0-9a-z_]+$`)
+
+// validLowercaseUnderscoreName returns a SchemaValidateFunc which tests that a string value
+// is between 1 and max characters and contains only lowercase alphanumeric characters and underscores.
+func validLowercaseUnderscoreName(kind string, max int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+
+		if len(value) < 1 {
+			errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+		} else if len(value) > max {
+			errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters", k, max))
+		} else if strings.ToLower(value) != value {
+			errors = append(errors, fmt.Errorf("%q (%s) must not contain uppercase characters; %s names are lowercase", k, value, kind))
+		} else if strings.Contains(value, "-") {
+			errors = append(errors, fmt.Errorf("%q (%s) must not contain hyphens; use underscores in %s names instead", k, value, kind))
+		} else if !lowercaseUnderscoreNameRegexp.MatchString(value) {
+			errors = append(errors, fmt.Errorf("%q (%s) can only contain lowercase alphanumeric characters and underscores", k, value))
+		}
+
+		return
+	}
+}
+
+// stringInSliceWithSuggestion returns a SchemaValidateFunc which tests that a string value
+// is one of the valid values, suggesting the closest valid value if it is not.
+func stringInSliceWithSuggestion(valid []string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if slices.Contains(valid, value) {
+			return
+		}
+
+		if match, ok := closestMatch(value, valid); ok {
+			errors = append(errors, fmt.Errorf("expected %s to be one of %q, got %s; did you mean %q?", k, valid, value, match))
+		} else {
+			errors = append(errors, fmt.Errorf("expected %s to be one of %q, got %s", k, valid, value))
+		}
+
+		return
+	}
+}
+
+// Route53RecordTypes are the DNS record types supported by Route 53.
+var Route53RecordTypes = []string{
+	"A",
+	"AAAA",
+	"CAA",
+	"CNAME",
+	"DS",
+	"MX",
+	"NAPTR",
+	"NS",
+	"PTR",
+	"SOA",
+	"SPF",
+	"SRV",
+	"TXT",
+}
+
+// ValidRoute53RecordType validates that a string value is a Route 53 record type.
+// Record types are case-sensitive; lowercase values are rejected with a suggestion.
+var ValidRoute53RecordType = stringInSliceWithSuggestion(Route53RecordTypes)
+
+const route53TXTRecordChunkMaxLen = 255
+
+// ValidRoute53TXTRecordValue validates a Route 53 TXT record value.
+// A value is either a sequence of quoted character strings, e.g. `"abc" "def"`, or an unquoted
+// value that is split into character strings on `""`. Each character string is limited to 255 characters.
+func ValidRoute53TXTRecordValue(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	chunks, err := route53TXTRecordChunks(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid TXT record value: %s", k, value, err))
+		return
+	}
+
+	for i, chunk := range chunks {
+		if n := len(chunk); n > route53TXTRecordChunkMaxLen {
+			errors = append(errors, fmt.Errorf("%q: TXT record character string %d is %d characters long, the maximum is %d; split long values into multiple character strings using \"\"", k, i, n, route53TXTRecordChunkMaxLen))
+		}
+	}
+
+	return
+}
+
+// route53TXTRecordChunks splits a TXT record value into its character strings.
+func route53TXTRecordChunks(value string) ([]string, error) {
+	if !strings.HasPrefix(value, `"`) {
+		return strings.Split(value, `""`), nil
+	}
+
+	var chunks []string
+
+	for rest := value; rest != ""; rest = strings.TrimLeft(rest, " \t") {
+		if rest[0] != '"' {
+			return nil, fmt.Errorf("unexpected characters after quoted character string %d", len(chunks))
+		}
+
+		var chunk strings.Builder
+		closed := false
+		i := 1
+
+		for ; i < len(rest); i++ {
+			c := rest[i]
+			if c == '\\' && i+1 < len(rest) {
+				i++
+				chunk.WriteByte(rest[i])
+				continue
+			}
+			if c == '"' {
+				closed = true
+				break
+			}
+			chunk.WriteByte(c)
+		}
+
+		if !closed {
+			return nil, fmt.Errorf("unterminated quoted character string %d", len(chunks))
+		}
+
+		chunks = append(chunks, chunk.String())
+		rest = rest[i+1:]
+	}
+
+	return chunks, nil
+}
+
+// EFSThroughputModes are the EFS file system throughput modes.
+var EFSThroughputModes = []string{
+	"bursting",
+	"elastic",
+	"provisioned",
+}
+
+// ValidEFSThroughputMode validates that a string value is an EFS file system throughput mode.
+var ValidEFSThroughputMode = validation.StringInSlice(EFSThroughputModes, false)
+
+// FSxDeploymentTypes are the deployment types across the FSx file system types.
+var FSxDeploymentTypes = []string{
+	"MULTI_AZ_1",
+	"PERSISTENT_1",
+	"PERSISTENT_2",
+	"SCRATCH_1",
+	"SCRATCH_2",
+	"SINGLE_AZ_1",
+	"SINGLE_AZ_2",
+}
+
+// ValidFSxDeploymentType validates that a string value is an FSx file system deployment type.
+var ValidFSxDeploymentType = validation.StringInSlice(FSxDeploymentTypes, false)
+
+// listValue returns the elements of a TypeList or TypeSet value.
+func listValue(v any) ([]any, bool) {
+	switch v := v.(type) {
+	case *schema.Set:
+		return v.List(), true
+	case []any:
+		return v, true
+	default:
+		return nil, false
+	}
+}
+
+var securityGroupIDRegexp = regexache.MustCompile(`^sg-([0-9a-f]{8}|[0-9a-f]{17})$`)
+
+// validateSecurityGroupID validates that the specified string is a VPC security group ID.
+func validateSecurityGroupID(s string) error {
+	if !securityGroupIDRegexp.MatchString(s) {
+		return fmt.Errorf("%q is not a valid security group ID (expected sg-xxxxxxxx or sg-xxxxxxxxxxxxxxxxx)", s)
+	}
+
+	return nil
+}
+
+// ValidSecurityGroupID validates that a string value is a VPC security group ID, e.g. "sg-0123456789abcdef0".
+func ValidSecurityGroupID(v interface{}, k string) (ws []string, errors []error) {
+	if err := validateSecurityGroupID(v.(string)); err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	return
+}
+
+// SecurityGroupIDsWithinLimit returns a SchemaValidateDiagFunc which tests that a list of security group IDs
+// has no more than max elements and that each element is a valid security group ID.
+// AWS allows 5 security groups per network interface by default, adjustable up to 16.
+func SecurityGroupIDsWithinLimit(max int) schema.SchemaValidateDiagFunc {
+	return func(v any, path cty.Path) diag.Diagnostics {
+		l, ok := listValue(v)
+		if !ok {
+			return diag.Diagnostics{errs.NewIncorrectValueTypeAttributeError(path, "list")}
+		}
+
+		var diags diag.Diagnostics
+
+		if n := len(l); n > max {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path, "Expected at most %d security group IDs, got %d", max, n))
+		}
+
+		for i, v := range l {
+			s, ok := v.(string)
+			if !ok {
+				diags = append(diags, errs.NewIncorrectValueTypeAttributeError(path.IndexInt(i), "string"))
+				continue
+			}
+
+			if err := validateSecurityGroupID(s); err != nil {
+				diags = append(diags, errs.NewInvalidValueAttributeError(path.IndexInt(i), err.Error()))
+			}
+		}
+
+		return diags
+	}
+}
+
+// ListLenBetween returns a SchemaValidateDiagFunc which tests that a list has between min and max elements, inclusive.
+// Unlike MinItems and MaxItems, the bounds can be chosen at runtime.
+func ListLenBetween(min, max int) schema.SchemaValidateDiagFunc {
+	return func(v any, path cty.Path) diag.Diagnostics {
+		l, ok := listValue(v)
+		if !ok {
+			return diag.Diagnostics{errs.NewIncorrectValueTypeAttributeError(path, "list")}
+		}
+
+		if n := len(l); n < min || n > max {
+			return diag.Diagnostics{errs.NewInvalidValueAttributeErrorf(path, "Expected between %d and %d elements, got %d", min, max, n)}
+		}
+
+		return nil
+	}
+}
+
+// IntListSumAtMost returns a SchemaValidateDiagFunc which tests that the integer elements
+// of the provided list or set value sum to no more than max, e.g. for capacity split across blocks.
+func IntListSumAtMost(max int) schema.SchemaValidateDiagFunc {
+	return func(v any, path cty.Path) diag.Diagnostics {
+		l, ok := listValue(v)
+		if !ok {
+			return diag.Diagnostics{errs.NewIncorrectValueTypeAttributeError(path, "list")}
+		}
+
+		var diags diag.Diagnostics
+		sum := 0
+
+		for i, v := range l {
+			n, ok := v.(int)
+			if !ok {
+				diags = append(diags, errs.NewIncorrectValueTypeAttributeError(path.IndexInt(i), "integer"))
+				continue
+			}
+			sum += n
+		}
+
+		if len(diags) > 0 {
+			return diags
+		}
+
+		if sum > max {
+			return diag.Diagnostics{errs.NewInvalidValueAttributeErrorf(path, "Expected elements to sum to at most %d, got %d", max, sum)}
+		}
+
+		return nil
+	}
+}
+
+// parsePortRange parses a single port, e.g. "443", or an inclusive port range, e.g. "1024-2048".
+func parsePortRange(s string) (int, int, error) {
+	parsePort := func(s string) (int, error) {
+		port, err := strconv.Atoi(s)
+		if err != nil || port < 0 || port > 65535 {
+			return 0, fmt.Errorf("%q is not a valid port", s)
+		}
+		return port, nil
+	}
+
+	before, after, found := strings.Cut(s, "-")
+
+	from, err := parsePort(before)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !found {
+		return from, from, nil
+	}
+
+	to, err := parsePort(after)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if from > to {
+		return 0, 0, fmt.Errorf("%q is not a valid port range, %d is greater than %d", s, from, to)
+	}
+
+	return from, to, nil
+}
+
+// PortRangesNoOverlap is a SchemaValidateDiagFunc which tests that a list of single ports, e.g. "443",
+// and inclusive port ranges, e.g. "1024-2048", contains no duplicates and no overlapping ranges.
+// Only the first conflicting pair is reported.
+func PortRangesNoOverlap(v any, path cty.Path) diag.Diagnostics {
+	l, ok := listValue(v)
+	if !ok {
+		return diag.Diagnostics{errs.NewIncorrectValueTypeAttributeError(path, "list")}
+	}
+
+	type portRange struct {
+		value    string
+		from, to int
+	}
+
+	var diags diag.Diagnostics
+	ranges := make([]*portRange, len(l))
+
+	for i, v := range l {
+		var s string
+		switch v := v.(type) {
+		case string:
+			s = v
+		case int:
+			s = strconv.Itoa(v)
+		default:
+			diags = append(diags, errs.NewIncorrectValueTypeAttributeError(path.IndexInt(i), "string"))
+			continue
+		}
+
+		from, to, err := parsePortRange(s)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeError(path.IndexInt(i), err.Error()))
+			continue
+		}
+
+		ranges[i] = &portRange{value: s, from: from, to: to}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	for j, b := range ranges {
+		for i, a := range ranges[:j] {
+			if a.from == b.from && a.to == b.to {
+				return diag.Diagnostics{errs.NewInvalidValueAttributeErrorf(path.IndexInt(j), "Duplicate port %q at indices %d and %d", b.value, i, j)}
+			}
+			if a.from <= b.to && b.from <= a.to {
+				return diag.Diagnostics{errs.NewInvalidValueAttributeErrorf(path.IndexInt(j), "Port %q at index %d overlaps port %q at index %d", b.value, j, a.value, i)}
+			}
+		}
+	}
+
+	return nil
+}
+
+// LambdaRuntimes are the supported Lambda function runtime identifiers.
+// See https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html.
+var LambdaRuntimes = []string{
+	"dotnet6",
+	"dotnet8",
+	"java8.al2",
+	"java11",
+	"java17",
+	"java21",
+	"nodejs18.x",
+	"nodejs20.x",
+	"nodejs22.x",
+	"provided.al2",
+	"provided.al2023",
+	"python3.9",
+	"python3.10",
+	"python3.11",
+	"python3.12",
+	"python3.13",
+	"ruby3.2",
+	"ruby3.3",
+}
+
+// LambdaDeprecatedRuntimes are the deprecated Lambda function runtime identifiers.
+var LambdaDeprecatedRuntimes = []string{
+	"dotnet5.0",
+	"dotnetcore1.0",
+	"dotnetcore2.0",
+	"dotnetcore2.1",
+	"dotnetcore3.1",
+	"go1.x",
+	"java8",
+	"nodejs",
+	"nodejs4.3",
+	"nodejs4.3-edge",
+	"nodejs6.10",
+	"nodejs8.10",
+	"nodejs10.x",
+	"nodejs12.x",
+	"nodejs14.x",
+	"nodejs16.x",
+	"provided",
+	"python2.7",
+	"python3.6",
+	"python3.7",
+	"python3.8",
+	"ruby2.5",
+	"ruby2.7",
+}
+
+// ValidLambdaRuntime validates that a string value is a Lambda function runtime identifier.
+// Deprecated runtimes produce a warning.
+func ValidLambdaRuntime(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if slices.Contains(LambdaRuntimes, value) {
+		return
+	}
+
+	if slices.Contains(LambdaDeprecatedRuntimes, value) {
+		ws = append(ws, fmt.Sprintf("%q (%s) is a deprecated Lambda runtime; consider upgrading to a supported runtime", k, value))
+		return
+	}
+
+	if match, ok := closestMatch(value, LambdaRuntimes); ok {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a known Lambda runtime; did you mean %q?", k, value, match))
+	} else {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a known Lambda runtime, expected one of %q", k, value, LambdaRuntimes))
+	}
+
+	return
+}
+
+const (
+	lambdaMemorySizeMin = 128
+	lambdaMemorySizeMax = 10240
+)
+
+// ValidLambdaMemorySize validates that an integer value is a valid Lambda function memory size in MB.
+// Memory can be configured in 1-MB increments between 128 MB and 10,240 MB.
+func ValidLambdaMemorySize(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be integer", k))
+		return
+	}
+
+	if value < lambdaMemorySizeMin || value > lambdaMemorySizeMax {
+		errors = append(errors, fmt.Errorf("%q (%d) must be between %d and %d MB", k, value, lambdaMemorySizeMin, lambdaMemorySizeMax))
+	}
+
+	return
+}
+
+var cloudWatchNamespaceRegexp = regexache.MustCompile(`^[0-9A-Za-z.\-_/#: ]+$`)
+
+// ValidCloudWatchNamespace validates that a string value is a CloudWatch metric namespace.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_Metric.html.
+func ValidCloudWatchNamespace(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 1 {
+		errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+	} else if len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 255 characters", k))
+	} else if strings.HasPrefix(value, ":") {
+		errors = append(errors, fmt.Errorf("%q (%s) cannot begin with a colon", k, value))
+	} else if !cloudWatchNamespaceRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) can only contain alphanumeric characters, spaces and .-_/#: symbols", k, value))
+	}
+
+	return
+}
+
+// ValidCloudWatchMetricName validates that a string value is a CloudWatch metric name.
+func ValidCloudWatchMetricName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 1 {
+		errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+	} else if len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 255 characters", k))
+	} else if strings.IndexFunc(value, unicode.IsControl) != -1 {
+		errors = append(errors, fmt.Errorf("%q (%q) cannot contain control characters", k, value))
+	}
+
+	return
+}
+
+// ValidJSONNoControlChars validates that a string value is JSON in which no string contains
+// control characters other than tab, newline and carriage return.
+// The JSON path of the first offending value is reported.
+func ValidJSONNoControlChars(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !utf8.ValidString(value) {
+		errors = append(errors, fmt.Errorf("%q contains invalid UTF-8", k))
+		return
+	}
+
+	var j any
+	if err := json.Unmarshal([]byte(value), &j); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
+	}
+
+	if path, ok := findJSONControlChars(j, "$"); ok {
+		errors = append(errors, fmt.Errorf("%q contains a disallowed control character at %s", k, path))
+	}
+
+	return
+}
+
+// findJSONControlChars returns the path of the first string in the JSON value that contains disallowed control characters.
+func findJSONControlChars(v any, path string) (string, bool) {
+	hasControlChars := func(s string) bool {
+		return strings.IndexFunc(s, func(r rune) bool {
+			return unicode.IsControl(r) && r != '\t' && r != '\n' && r != '\r'
+		}) != -1
+	}
+
+	switch v := v.(type) {
+	case string:
+		if hasControlChars(v) {
+			return path, true
+		}
+	case []any:
+		for i, v := range v {
+			if path, ok := findJSONControlChars(v, fmt.Sprintf("%s[%d]", path, i)); ok {
+				return path, true
+			}
+		}
+	case map[string]any:
+		keys := maps.Keys(v)
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			path := fmt.Sprintf("%s[%q]", path, key)
+			if hasControlChars(key) {
+				return path, true
+			}
+			if path, ok := findJSONControlChars(v[key], path); ok {
+				return path, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+var dbParameterGroupFamilyRegexp = regexache.MustCompile(`^[a-z]+(-[a-z]+)*-?[0-9]+(\.[0-9]+)*$`)
+
+// DBParameterGroupFamilies returns the known RDS DB parameter group families.
+func DBParameterGroupFamilies() []string {
+	return []string{
+		"aurora-mysql5.7",
+		"aurora-mysql8.0",
+		"aurora-postgresql11",
+		"aurora-postgresql12",
+		"aurora-postgresql13",
+		"aurora-postgresql14",
+		"aurora-postgresql15",
+		"aurora-postgresql16",
+		"mariadb10.4",
+		"mariadb10.5",
+		"mariadb10.6",
+		"mariadb10.11",
+		"mysql5.7",
+		"mysql8.0",
+		"oracle-ee-19",
+		"oracle-ee-cdb-19",
+		"oracle-ee-cdb-21",
+		"oracle-se2-19",
+		"oracle-se2-cdb-19",
+		"oracle-se2-cdb-21",
+		"postgres11",
+		"postgres12",
+		"postgres13",
+		"postgres14",
+		"postgres15",
+		"postgres16",
+		"sqlserver-ee-15.0",
+		"sqlserver-ee-16.0",
+		"sqlserver-ex-15.0",
+		"sqlserver-ex-16.0",
+		"sqlserver-se-15.0",
+		"sqlserver-se-16.0",
+		"sqlserver-web-15.0",
+		"sqlserver-web-16.0",
+	}
+}
+
+// ValidDBParameterGroupFamily validates that a string value is shaped like an RDS DB parameter group
+// family, an engine name followed by a version, e.g. "mysql8.0" or "postgres15".
+// Families that are well-formed but not known produce a warning.
+func ValidDBParameterGroupFamily(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !dbParameterGroupFamilyRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) must be an engine name followed by a version, e.g. mysql8.0 or postgres15", k, value))
+		return
+	}
+
+	if !slices.Contains(DBParameterGroupFamilies(), value) {
+		ws = append(ws, fmt.Sprintf("%q (%s) is not a known DB parameter group family", k, value))
+	}
+
+	return
+}
+
+var cacheNodeTypeRegexp = regexache.MustCompile(`^cache\.[a-z][0-9a-z-]*\.[0-9a-z]+$`)
+
+// CacheNodeFamilies returns the known ElastiCache node type families.
+func CacheNodeFamilies() []string {
+	return []string{
+		"c7gn",
+		"m4",
+		"m5",
+		"m6g",
+		"m7g",
+		"r4",
+		"r5",
+		"r6g",
+		"r6gd",
+		"r7g",
+		"t2",
+		"t3",
+		"t4g",
+	}
+}
+
+// ValidCacheNodeType validates that a string value is shaped like an ElastiCache node type,
+// "cache.<family>.<size>", e.g. "cache.t3.micro" or "cache.r6g.large".
+// Node types whose family is well-formed but not known produce a warning.
+func ValidCacheNodeType(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !strings.HasPrefix(value, "cache.") {
+		errors = append(errors, fmt.Errorf("%q (%s) must begin with \"cache.\"", k, value))
+		return
+	}
+
+	if !cacheNodeTypeRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) must be of the form cache.<family>.<size>, e.g. cache.t3.micro", k, value))
+		return
+	}
+
+	if family := strings.Split(value, ".")[1]; !slices.Contains(CacheNodeFamilies(), family) {
+		ws = append(ws, fmt.Sprintf("%q (%s) has an unknown node type family: %s", k, value, family))
+	}
+
+	return
+}
+
+// ValidRegexp validates that a string value is a valid regular expression in Go's RE2 syntax.
+func ValidRegexp(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := regexp.Compile(value); err != nil {
+		if serr, ok := errs.As[*syntax.Error](err); ok {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a valid regular expression: %s: `%s`", k, value, serr.Code, serr.Expr))
+			return
+		}
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid regular expression: %w", k, value, err))
+	}
+
+	return
+}
+
+// CountryCodes are the ISO 3166-1 alpha-2 country codes.
+// See https://www.iso.org/iso-3166-country-codes.html.
+var CountryCodes = []string{
+	"AD", "AE", "AF", "AG", "AI", "AL", "AM", "AO", "AQ", "AR", "AS", "AT", "AU", "AW", "AX", "AZ",
+	"BA", "BB", "BD", "BE", "BF", "BG", "BH", "BI", "BJ", "BL", "BM", "BN", "BO", "BQ", "BR", "BS",
+	"BT", "BV", "BW", "BY", "BZ", "CA", "CC", "CD", "CF", "CG", "CH", "CI", "CK", "CL", "CM", "CN",
+	"CO", "CR", "CU", "CV", "CW", "CX", "CY", "CZ", "DE", "DJ", "DK", "DM", "DO", "DZ", "EC", "EE",
+	"EG", "EH", "ER", "ES", "ET", "FI", "FJ", "FK", "FM", "FO", "FR", "GA", "GB", "GD", "GE", "GF",
+	"GG", "GH", "GI", "GL", "GM", "GN", "GP", "GQ", "GR", "GS", "GT", "GU", "GW", "GY", "HK", "HM",
+	"HN", "HR", "HT", "HU", "ID", "IE", "IL", "IM", "IN", "IO", "IQ", "IR", "IS", "IT", "JE", "JM",
+	"JO", "JP", "KE", "KG", "KH", "KI", "KM", "KN", "KP", "KR", "KW", "KY", "KZ", "LA", "LB", "LC",
+	"LI", "LK", "LR", "LS", "LT", "LU", "LV", "LY", "MA", "MC", "MD", "ME", "MF", "MG", "MH", "MK",
+	"ML", "MM", "MN", "MO", "MP", "MQ", "MR", "MS", "MT", "MU", "MV", "MW", "MX", "MY", "MZ", "NA",
+	"NC", "NE", "NF", "NG", "NI", "NL", "NO", "NP", "NR", "NU", "NZ", "OM", "PA", "PE", "PF", "PG",
+	"PH", "PK", "PL", "PM", "PN", "PR", "PS", "PT", "PW", "PY", "QA", "RE", "RO", "RS", "RU", "RW",
+	"SA", "SB", "SC", "SD", "SE", "SG", "SH", "SI", "SJ", "SK", "SL", "SM", "SN", "SO", "SR", "SS",
+	"ST", "SV", "SX", "SY", "SZ", "TC", "TD", "TF", "TG", "TH", "TJ", "TK", "TL", "TM", "TN", "TO",
+	"TR", "TT", "TV", "TW", "TZ", "UA", "UG", "UM", "US", "UY", "UZ", "VA", "VC", "VE", "VG", "VI",
+	"VN", "VU", "WF", "WS", "YE", "YT", "ZA", "ZM", "ZW",
+}
+
+// countryCodeCorrections maps commonly used codes that are not ISO 3166-1 alpha-2 codes to the correct code,
+// where the closest matching code would be a different country.
+var countryCodeCorrections = map[string]string{
+	"EL":  "GR", // Greece, as used by the European Union.
+	"UK":  "GB", // United Kingdom.
+	"USA": "US", // United States, the ISO 3166-1 alpha-3 code.
+}
+
+// ValidCountryCode validates that a string value is an ISO 3166-1 alpha-2 country code, e.g. "US".
+// The comparison ignores case. Invalid codes are reported with the closest matching code, if any.
+func ValidCountryCode(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	code := strings.ToUpper(value)
+
+	if slices.Contains(CountryCodes, code) {
+		return
+	}
+
+	correction, ok := countryCodeCorrections[code]
+	if !ok {
+		correction, ok = closestMatch(code, CountryCodes)
+	}
+
+	if ok {
+		errors = append(errors, fmt.Errorf("%q (%s) is not an ISO 3166-1 alpha-2 country code; did you mean %q?", k, value, correction))
+		return
+	}
+
+	errors = append(errors, fmt.Errorf("%q (%s) is not an ISO 3166-1 alpha-2 country code", k, value))
+
+	return
+}
+
+const (
+	xraySamplingPriorityMin = 1
+	xraySamplingPriorityMax = 9999
+)
+
+// ValidXRaySamplingRate validates that a float value is a valid X-Ray sampling rule fixed rate,
+// the fraction of requests to sample, between 0.0 and 1.0 inclusive.
+func ValidXRaySamplingRate(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(float64)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be float", k))
+		return
+	}
+
+	if value < 0 || value > 1 {
+		errors = append(errors, fmt.Errorf("%q (%g) must be between 0.0 and 1.0", k, value))
+	}
+
+	return
+}
+
+// ValidXRaySamplingPriority validates that an integer value is a valid X-Ray sampling rule priority,
+// between 1 and 9999 inclusive.
+func ValidXRaySamplingPriority(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be integer", k))
+		return
+	}
+
+	if value < xraySamplingPriorityMin || value > xraySamplingPriorityMax {
+		errors = append(errors, fmt.Errorf("%q (%d) must be between %d and %d", k, value, xraySamplingPriorityMin, xraySamplingPriorityMax))
+	}
+
+	return
+}
+
+var iamRootPrincipalRegexp = regexache.MustCompile(`^arn:[^:]+:iam::\d{12}:root$`)
+
+// kmsKeyAdminActions are the actions which, granted to the account root principal, allow the
+// account to regain control of a KMS key through IAM policies.
+var kmsKeyAdminActions = []string{
+	"*",
+	"kms:*",
+	"kms:PutKeyPolicy",
+}
+
+// ValidKMSKeyPolicyRootAccess validates that a string value is a KMS key policy containing a statement
+// that allows the account root principal, e.g. "arn:aws:iam::123456789012:root", full ("kms:*") or
+// key policy administration ("kms:PutKeyPolicy") access.
+// Without such a statement the key can become unmanageable, but as some designs intentionally
+// restrict root access a warning is produced rather than an error.
+// See https://docs.aws.amazon.com/kms/latest/developerguide/key-policy-default.html#key-policy-default-allow-root-enable-iam.
+func ValidKMSKeyPolicyRootAccess(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		return
+	}
+
+	statements, err := iamPolicyStatements(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON policy: %s", k, err))
+		return
+	}
+
+	for _, statement := range statements {
+		if statement["Effect"] != "Allow" {
+			continue
+		}
+
+		actions, _ := iamPolicyStringValues(statement["Action"])
+		if !slices.ContainsFunc(actions, func(action string) bool {
+			return slices.ContainsFunc(kmsKeyAdminActions, func(adminAction string) bool {
+				return strings.EqualFold(action, adminAction)
+			})
+		}) {
+			continue
+		}
+
+		principals, _ := statement["Principal"].(map[string]any)
+		awsPrincipals, _ := iamPolicyStringValues(principals["AWS"])
+		if slices.ContainsFunc(awsPrincipals, func(principal string) bool {
+			return awsPrincipalAccountIDRegexp.MatchString(principal) || iamRootPrincipalRegexp.MatchString(principal)
+		}) {
+			return
+		}
+	}
+
+	ws = append(ws, fmt.Sprintf("%q: no statement allows the account root principal (arn:<partition>:iam::<account>:root) kms:* or kms:PutKeyPolicy access; the KMS key may become unmanageable", k))
+
+	return
+}
+
+var instanceTypeRegexp = regexache.MustCompile(`^[a-z][0-9a-z-]*[0-9][0-9a-z-]*\.[0-9a-z-]+$`)
+
+// InstanceTypeFamilies returns the known EC2 instance type families.
+// See https://docs.aws.amazon.com/ec2/latest/instancetypes/instance-type-names.html.
+func InstanceTypeFamilies() []string {
+	return []string{
+		"a1",
+		"c4", "c5", "c5a", "c5ad", "c5d", "c5n", "c6a", "c6g", "c6gd", "c6gn", "c6i", "c6id", "c6in", "c7a", "c7g", "c7gd", "c7gn", "c7i",
+		"d2", "d3", "d3en",
+		"dl1",
+		"f1",
+		"g4ad", "g4dn", "g5", "g5g", "g6",
+		"hpc6a", "hpc6id", "hpc7a", "hpc7g",
+		"i3", "i3en", "i4g", "i4i", "im4gn", "is4gen",
+		"inf1", "inf2",
+		"m4", "m5", "m5a", "m5ad", "m5d", "m5dn", "m5n", "m5zn", "m6a", "m6g", "m6gd", "m6i", "m6id", "m6idn", "m6in", "m7a", "m7g", "m7gd", "m7i", "m7i-flex",
+		"mac1", "mac2",
+		"p3", "p3dn", "p4d", "p5",
+		"r4", "r5", "r5a", "r5ad", "r5b", "r5d", "r5dn", "r5n", "r6a", "r6g", "r6gd", "r6i", "r6id", "r6idn", "r6in", "r7a", "r7g", "r7gd", "r7i", "r7iz",
+		"t2", "t3", "t3a", "t4g",
+		"trn1", "trn1n",
+		"vt1",
+		"x1", "x1e", "x2gd", "x2idn", "x2iedn", "x2iezn",
+		"z1d",
+	}
+}
+
+// ValidInstanceType validates that a string value is shaped like an EC2 instance type,
+// "<family><generation>.<size>", e.g. "m5.large" or "c6gn.16xlarge".
+// Instance types whose family is well-formed but not known produce a warning.
+func ValidInstanceType(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !instanceTypeRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) must be of the form <family><generation>.<size>, e.g. m5.large", k, value))
+		return
+	}
+
+	if family, _, _ := strings.Cut(value, "."); !slices.Contains(InstanceTypeFamilies(), family) {
+		ws = append(ws, fmt.Sprintf("%q (%s) has an unknown instance type family: %s", k, value, family))
+	}
+
+	return
+}
+
+var batchNameRegexp = regexache.MustCompile(`^[0-9A-Za-z_-]+$`)
+
+// ValidBatchName validates that a string value is a valid Batch job definition or compute environment name.
+// Names can be up to 128 characters long and contain uppercase and lowercase letters, numbers, hyphens and underscores.
+func ValidBatchName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 1 {
+		errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+	} else if len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 128 characters", k))
+	} else if !batchNameRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) can only contain uppercase and lowercase letters, numbers, hyphens and underscores", k, value))
+	}
+
+	return
+}
+
+// ViewerProtocolPolicies are the CloudFront cache behavior viewer protocol policies.
+var ViewerProtocolPolicies = []string{
+	"allow-all",
+	"https-only",
+	"redirect-to-https",
+}
+
+// ValidViewerProtocolPolicy validates that a string value is a CloudFront viewer protocol policy.
+var ValidViewerProtocolPolicy = stringInSliceWithSuggestion(ViewerProtocolPolicies)
+
+// ValidGoTemplate returns a SchemaValidateFunc which tests that a string value is a valid Go text/template.
+// If allowedFuncs are specified, templates may only call those functions and the predefined
+// global functions, e.g. "printf" or "len"; otherwise calls to any function are accepted.
+func ValidGoTemplate(allowedFuncs ...string) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		var err error
+		if len(allowedFuncs) == 0 {
+			tree := parse.New(k)
+			tree.Mode = parse.SkipFuncCheck
+			_, err = tree.Parse(value, "", "", make(map[string]*parse.Tree))
+		} else {
+			funcs := make(template.FuncMap, len(allowedFuncs))
+			for _, name := range allowedFuncs {
+				funcs[name] = func(...any) string { return "" }
+			}
+			_, err = template.New(k).Funcs(funcs).Parse(value)
+		}
+
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid Go template: %s", k, err))
+		}
+
+		return
+	}
+}
+
+var sageMakerNameRegexp = regexache.MustCompile(`^[0-9A-Za-z](-*[0-9A-Za-z])*$`)
+
+// ValidSageMakerName validates that a string value is a valid SageMaker resource name.
+// Names are 1 to 63 alphanumeric characters, optionally separated by hyphens.
+// See https://docs.aws.amazon.com/sagemaker/latest/APIReference/API_CreateModel.html#sagemaker-CreateModel-request-ModelName.
+var ValidSageMakerName = validSageMakerName(63, false)
+
+// ValidSageMakerNamePrefix validates that a string value is a valid SageMaker resource name prefix.
+// The prefix is followed by a generated unique suffix, so it is shorter than a name and may end with a hyphen.
+var ValidSageMakerNamePrefix = validSageMakerName(63-id.UniqueIDSuffixLength, true)
+
+func validSageMakerName(max int, prefix bool) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		name := value
+		if prefix {
+			name = strings.TrimRight(value, "-")
+		}
+
+		if len(value) < 1 {
+			errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+		} else if len(value) > max {
+			errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters", k, max))
+		} else if strings.HasPrefix(value, "-") {
+			errors = append(errors, fmt.Errorf("%q (%s) must begin with an alphanumeric character", k, value))
+		} else if strings.HasSuffix(name, "-") {
+			errors = append(errors, fmt.Errorf("%q (%s) must not end with a hyphen", k, value))
+		} else if !sageMakerNameRegexp.MatchString(name) {
+			errors = append(errors, fmt.Errorf("%q (%s) can only contain alphanumeric characters and hyphens", k, value))
+		}
+
+		return
+	}
+}
+
+var s3BucketNameRegexp = regexache.MustCompile(`^[0-9a-z][0-9a-z.-]*[0-9a-z]$`)
+
+// ValidS3BucketName validates that a string value is a DNS-compliant S3 bucket name:
+// 3 to 63 lowercase letters, numbers, periods and hyphens, beginning and ending with a letter or number,
+// with no adjacent periods, and not formatted as an IP address.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucketnamingrules.html.
+func ValidS3BucketName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 3 || len(value) > 63 {
+		errors = append(errors, fmt.Errorf("%q (%s) must contain from 3 to 63 characters", k, value))
+	} else if !s3BucketNameRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) can only contain lowercase alphanumeric characters, periods and hyphens, and must begin and end with a lowercase alphanumeric character", k, value))
+	} else if strings.Contains(value, "..") {
+		errors = append(errors, fmt.Errorf("%q (%s) must not contain two adjacent periods", k, value))
+	} else {
+		ws, errors = StringIsNotIPv4(v, k)
+	}
+
+	return
+}
+
+// ValidS3PlainBucketName validates that a string value is an S3 bucket name, as ValidS3BucketName,
+// and not an access point alias ("...-s3alias"), an S3 on Outposts access point alias ("...--op-s3")
+// or an ARN, which some APIs accept in place of a bucket name.
+func ValidS3PlainBucketName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	switch {
+	case strings.HasPrefix(value, "arn:"):
+		errors = append(errors, fmt.Errorf("%q (%s) is an ARN; specify the bucket name instead", k, value))
+		return
+	case strings.HasSuffix(value, "-s3alias"):
+		errors = append(errors, fmt.Errorf("%q (%s) is an S3 access point alias; specify the bucket name instead", k, value))
+		return
+	case strings.HasSuffix(value, "--op-s3"):
+		errors = append(errors, fmt.Errorf("%q (%s) is an S3 on Outposts access point alias; specify the bucket name instead", k, value))
+		return
+	}
+
+	return ValidS3BucketName(v, k)
+}
+
+// ValidS3WebsiteBucketName validates that a string value is an S3 bucket name, as ValidS3BucketName,
+// for a bucket hosting a static website. A warning is returned if the name contains periods, as the
+// bucket's virtual-hosted-style endpoint then does not match the S3 wildcard SSL certificate, breaking
+// HTTPS access, e.g. from CloudFront.
+func ValidS3WebsiteBucketName(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = ValidS3BucketName(v, k)
+	if len(errors) > 0 {
+		return
+	}
+
+	if value := v.(string); strings.Contains(value, ".") {
+		ws = append(ws, fmt.Sprintf("%q (%s) contains periods; website buckets with periods in their names cannot be accessed over HTTPS using their virtual-hosted-style endpoint", k, value))
+	}
+
+	return
+}
+
+// FindingPublishingFrequencies are the finding publishing frequencies supported by GuardDuty, Detective and Macie.
+var FindingPublishingFrequencies = []string{
+	"FIFTEEN_MINUTES",
+	"ONE_HOUR",
+	"SIX_HOURS",
+}
+
+// ValidFindingPublishingFrequency validates that a string value is a finding publishing frequency.
+var ValidFindingPublishingFrequency = stringInSliceWithSuggestion(FindingPublishingFrequencies)
+
+const (
+	dashboardBodyMaxSize = 100 * 1024
+	dashboardGridColumns = 24
+)
+
+// ValidDashboardBody validates that a string value is a CloudWatch dashboard body: a JSON object
+// no larger than 100 KB with a "widgets" array, in which each widget has a "type" and is placed
+// within the 24-column grid.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+func ValidDashboardBody(v interface{}, k string) (ws []string, errors []error) {
+	ws, errors = ValidJSONMaxSize(dashboardBodyMaxSize)(v, k)
+	if len(errors) > 0 {
+		return
+	}
+
+	var body struct {
+		Widgets *[]map[string]any `json:"widgets"`
+	}
+
+	if err := json.Unmarshal([]byte(v.(string)), &body); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid dashboard body: %s", k, err))
+		return
+	}
+
+	if body.Widgets == nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid dashboard body: widgets array is required", k))
+		return
+	}
+
+	for i, widget := range *body.Widgets {
+		if typ, ok := widget["type"].(string); !ok || typ == "" {
+			errors = append(errors, fmt.Errorf("%q: widget %d: type is required", k, i))
+		}
+
+		x, width := 0.0, 6.0
+		if v, ok := widget["x"].(float64); ok {
+			x = v
+		}
+		if v, ok := widget["width"].(float64); ok {
+			width = v
+		}
+
+		if x < 0 || x >= dashboardGridColumns {
+			errors = append(errors, fmt.Errorf("%q: widget %d: x (%g) must be between 0 and %d", k, i, x, dashboardGridColumns-1))
+		} else if width < 1 || x+width > dashboardGridColumns {
+			errors = append(errors, fmt.Errorf("%q: widget %d: width (%g) at x (%g) must fit within the %d-column grid", k, i, width, x, dashboardGridColumns))
+		}
+	}
+
+	return
+}
+
+var vaultNameRegexp = regexache.MustCompile(`^[0-9A-Za-z_-]+$`)
+
+// ValidVaultName validates that a string value is a valid Glacier or Backup vault name.
+// Names can be up to 255 characters long and contain letters, numbers, hyphens and underscores.
+func ValidVaultName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 1 {
+		errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+	} else if len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 255 characters", k))
+	} else if strings.ContainsAny(value, " \t") {
+		errors = append(errors, fmt.Errorf("%q (%s) must not contain spaces; use hyphens or underscores instead", k, value))
+	} else if !vaultNameRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) can only contain letters, numbers, hyphens and underscores", k, value))
+	}
+
+	return
+}
+
+const (
+	targetGroupWeightMin = 0
+	targetGroupWeightMax = 999
+)
+
+// ValidTargetGroupWeight validates that an integer value is a valid weight for a target group
+// in a weighted forward action, between 0 and 999 inclusive.
+func ValidTargetGroupWeight(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be integer", k))
+		return
+	}
+
+	if value < targetGroupWeightMin || value > targetGroupWeightMax {
+		errors = append(errors, fmt.Errorf("%q (%d) must be between %d and %d", k, value, targetGroupWeightMin, targetGroupWeightMax))
+	}
+
+	return
+}
+
+// validDashedIdentifier returns a SchemaValidateFunc which tests that a string value is an identifier
+// of 1 to max lowercase alphanumeric characters and hyphens, beginning with a letter,
+// and neither ending with a hyphen nor containing two consecutive hyphens.
+func validDashedIdentifier(max int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+
+		if len(value) < 1 {
+			errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+			return
+		}
+		if len(value) > max {
+			errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters", k, max))
+		}
+		if !regexache.MustCompile(`^[a-z]`).MatchString(value) {
+			errors = append(errors, fmt.Errorf("first character of %q must be a letter", k))
+		}
+		if !regexache.MustCompile(`^[0-9a-z-]+$`).MatchString(value) {
+			errors = append(errors, fmt.Errorf("only lowercase alphanumeric characters and hyphens allowed in %q", k))
+		}
+		if strings.Contains(value, "--") {
+			errors = append(errors, fmt.Errorf("%q cannot contain two consecutive hyphens", k))
+		}
+		if strings.HasSuffix(value, "-") {
+			errors = append(errors, fmt.Errorf("%q cannot end with a hyphen", k))
+		}
+
+		return
+	}
+}
+
+// ValidRedshiftClusterIdentifier validates that a string value is a valid Redshift cluster identifier.
+// See https://docs.aws.amazon.com/redshift/latest/APIReference/API_CreateCluster.html.
+var ValidRedshiftClusterIdentifier = validDashedIdentifier(63)
+
+// RedshiftNodeTypes are the Redshift cluster node types.
+// See https://docs.aws.amazon.com/redshift/latest/mgmt/working-with-clusters.html#rs-node-type-info.
+var RedshiftNodeTypes = []string{
+	"dc2.8xlarge",
+	"dc2.large",
+	"ds2.8xlarge",
+	"ds2.xlarge",
+	"ra3.16xlarge",
+	"ra3.4xlarge",
+	"ra3.large",
+	"ra3.xlplus",
+}
+
+// ValidRedshiftNodeType validates that a string value is a Redshift cluster node type.
+var ValidRedshiftNodeType = stringInSliceWithSuggestion(RedshiftNodeTypes)
+
+var iotThingNameRegexp = regexache.MustCompile(`^[0-9A-Za-z:_-]+$`)
+
+// ValidIoTThingName validates that a string value is a valid IoT thing name.
+// Names can be up to 128 characters long and contain letters, numbers, colons, hyphens and underscores.
+func ValidIoTThingName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 1 {
+		errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+	} else if len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 128 characters", k))
+	} else if !iotThingNameRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) can only contain letters, numbers, colons, hyphens and underscores", k, value))
+	}
+
+	return
+}
+
+const iotTopicMaxBytes = 256
+
+// ValidIoTTopic returns a SchemaValidateFunc which tests that a string value is a valid IoT MQTT topic
+// of no more than 256 bytes. Topic filters used to subscribe may contain the "+" (single-level) and
+// "#" (multi-level) wildcards, each occupying an entire topic level and "#" only as the last level;
+// topics used to publish must not contain wildcards.
+// See https://docs.aws.amazon.com/iot/latest/developerguide/topics.html.
+func ValidIoTTopic(subscribe bool) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+
+		if len(value) < 1 {
+			errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+			return
+		}
+
+		if n := len(value); n > iotTopicMaxBytes {
+			errors = append(errors, fmt.Errorf("%q is %d bytes long, the maximum is %d bytes", k, n, iotTopicMaxBytes))
+			return
+		}
+
+		if !subscribe {
+			if strings.ContainsAny(value, "#+") {
+				errors = append(errors, fmt.Errorf("%q (%s) must not contain the wildcard characters \"#\" or \"+\" in a topic used to publish", k, value))
+			}
+			return
+		}
+
+		levels := strings.Split(value, "/")
+		for i, level := range levels {
+			if strings.Contains(level, "#") && (level != "#" || i != len(levels)-1) {
+				errors = append(errors, fmt.Errorf("%q (%s) must only contain the \"#\" wildcard as the entire last topic level", k, value))
+				return
+			}
+			if strings.Contains(level, "+") && level != "+" {
+				errors = append(errors, fmt.Errorf("%q (%s) must only contain the \"+\" wildcard as an entire topic level", k, value))
+				return
+			}
+		}
+
+		return
+	}
+}
+
+var uuidRegexp = regexache.MustCompile(`^(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// ValidUUID validates that a string value is a UUID in the canonical 8-4-4-4-12 hexadecimal form,
+// e.g. "1234abcd-12ab-34cd-56ef-1234567890ab", ignoring case.
+func ValidUUID(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !uuidRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) must be a UUID of the form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", k, value))
+	}
+
+	return
+}
+
+// ValidEMRClusterName validates that a string value is a valid EMR cluster name:
+// 1 to 256 printable characters, excluding control characters.
+func ValidEMRClusterName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 1 {
+		errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+	} else if len(value) > 256 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 256 characters", k))
+	} else if strings.IndexFunc(value, func(r rune) bool { return !unicode.IsPrint(r) && r != ' ' }) != -1 {
+		errors = append(errors, fmt.Errorf("%q (%q) can only contain printable characters", k, value))
+	}
+
+	return
+}
+
+var dnsLabelRegexp = regexache.MustCompile(`^[0-9A-Za-z]([0-9A-Za-z-]*[0-9A-Za-z])?$`)
+
+// validateDNSName validates that the specified string is a fully qualified DNS domain name,
+// e.g. "example.com", of at least two labels. A trailing period is allowed.
+func validateDNSName(s string) error {
+	name := strings.TrimSuffix(s, ".")
+
+	if len(name) > 253 {
+		return fmt.Errorf("%q is not a valid domain name: cannot be longer than 253 characters", s)
+	}
+
+	labels := strings.Split(name, ".")
+	if len(labels) < 2 {
+		return fmt.Errorf("%q is not a valid domain name: must have at least two labels", s)
+	}
+
+	for _, label := range labels {
+		if len(label) < 1 || len(label) > 63 {
+			return fmt.Errorf("%q is not a valid domain name: labels must be between 1 and 63 characters", s)
+		}
+		if !dnsLabelRegexp.MatchString(label) {
+			return fmt.Errorf("%q is not a valid domain name: label %q can only contain alphanumeric characters and hyphens, and cannot begin or end with a hyphen", s, label)
+		}
+	}
+
+	return nil
+}
+
+// ValidDNSName validates that a string value is a fully qualified DNS domain name, e.g. "example.com".
+func ValidDNSName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if err := validateDNSName(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+// validateEmailAddress validates that the specified string is a bare email address, e.g. "user@example.com",
+// with no display name, whose domain is a valid DNS domain name.
+func validateEmailAddress(s string) error {
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Name != "" || addr.Address != s {
+		return fmt.Errorf("%q is not a valid email address", s)
+	}
+
+	domain := s[strings.LastIndex(s, "@")+1:]
+	if err := validateDNSName(domain); err != nil {
+		return fmt.Errorf("%q is not a valid email address: %w", s, err)
+	}
+
+	return nil
+}
+
+// ValidEmailAddress validates that a string value is an email address, e.g. "user@example.com".
+func ValidEmailAddress(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if err := validateEmailAddress(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+// ValidSESIdentity validates that a string value is an SES identity:
+// either an email address, e.g. "user@example.com", or a domain name, e.g. "example.com".
+func ValidSESIdentity(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if strings.Contains(value, "@") {
+		if validateEmailAddress(value) == nil {
+			return
+		}
+	} else if validateDNSName(value) == nil {
+		return
+	}
+
+	errors = append(errors, fmt.Errorf("%q (%s) must be either an email address (e.g. user@example.com) or a domain name (e.g. example.com)", k, value))
+
+	return
+}
+
+var loadBalancerNameRegexp = regexache.MustCompile(`^[0-9A-Za-z-]+$`)
+
+// ValidLoadBalancerName validates that a string value is a valid Application or Network Load Balancer name:
+// 1 to 32 alphanumeric characters or hyphens, not beginning or ending with a hyphen, and not beginning with "internal-".
+func ValidLoadBalancerName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 1 {
+		errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+		return
+	}
+	if len(value) > 32 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 32 characters", k))
+	}
+	if !loadBalancerNameRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("only alphanumeric characters and hyphens allowed in %q (%s)", k, value))
+	}
+	if strings.HasPrefix(value, "-") || strings.HasSuffix(value, "-") {
+		errors = append(errors, fmt.Errorf("%q (%s) cannot begin or end with a hyphen", k, value))
+	}
+	if strings.HasPrefix(value, "internal-") {
+		errors = append(errors, fmt.Errorf("%q (%s) cannot begin with \"internal-\", it is reserved for internal load balancer DNS names", k, value))
+	}
+
+	return
+}
+
+const transferHomeDirectoryMaxLength = 1024
+
+// validateTransferHomeDirectory validates that the specified string is an absolute Transfer Family path.
+func validateTransferHomeDirectory(s string) error {
+	if !strings.HasPrefix(s, "/") {
+		return fmt.Errorf("must be an absolute path beginning with \"/\", got %q", s)
+	}
+	if len(s) > transferHomeDirectoryMaxLength {
+		return fmt.Errorf("cannot be longer than %d characters", transferHomeDirectoryMaxLength)
+	}
+
+	return nil
+}
+
+// ValidTransferHomeDirectory validates that a string value is a Transfer Family user home directory:
+// an absolute path, e.g. "/bucket/home/user", of at most 1024 characters.
+func ValidTransferHomeDirectory(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if err := validateTransferHomeDirectory(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q %s", k, err))
+	}
+
+	return
+}
+
+// ValidTransferHomeDirectoryMappings validates that a string value is a JSON-encoded array of Transfer Family
+// logical directory mappings, e.g. `[{"Entry": "/", "Target": "/bucket/home/user"}]`, in which each mapping
+// has absolute "Entry" and "Target" paths and an optional "Type" of "FILE" or "DIRECTORY".
+func ValidTransferHomeDirectoryMappings(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	var mappings []map[string]any
+	if err := json.Unmarshal([]byte(value), &mappings); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON array of mapping objects: %s", k, err))
+		return
+	}
+
+	for i, mapping := range mappings {
+		for _, key := range []string{"Entry", "Target"} {
+			path, ok := mapping[key].(string)
+			if !ok {
+				errors = append(errors, fmt.Errorf("%q: mapping %d: %s is required", k, i, key))
+				continue
+			}
+			if err := validateTransferHomeDirectory(path); err != nil {
+				errors = append(errors, fmt.Errorf("%q: mapping %d: %s %s", k, i, key, err))
+			}
+		}
+
+		if v, ok := mapping["Type"]; ok {
+			if typ, _ := v.(string); typ != "FILE" && typ != "DIRECTORY" {
+				errors = append(errors, fmt.Errorf("%q: mapping %d: Type must be one of [\"FILE\" \"DIRECTORY\"], got %v", k, i, v))
+			}
+		}
+	}
+
+	return
+}
+
+var hexColorRegexp = regexache.MustCompile(`^(?i)[0-9a-f]+$`)
+
+// ValidHexColor validates that a string value is a hex color code in either the "#RRGGBB" or the
+// shorthand "#RGB" form, e.g. "#FFAA00" or "#FA0". Hex digits are case-insensitive.
+func ValidHexColor(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	digits, ok := strings.CutPrefix(value, "#")
+	if !ok {
+		errors = append(errors, fmt.Errorf("%q (%s) must begin with \"#\", e.g. \"#FFAA00\"", k, value))
+		return
+	}
+
+	if n := len(digits); n != 3 && n != 6 {
+		errors = append(errors, fmt.Errorf("%q (%s) must have 3 or 6 hex digits, got %d", k, value, n))
+	} else if !hexColorRegexp.MatchString(digits) {
+		errors = append(errors, fmt.Errorf("%q (%s) can only contain hex digits (0-9, A-F) after \"#\"", k, value))
+	}
+
+	return
+}
+
+// IntInRangeOrValue returns a SchemaValidateFunc which tests that an integer value is between min and max,
+// inclusive, or is exactly sentinel, e.g. -1, which many AWS APIs use to mean "unlimited".
+func IntInRangeOrValue(min, max, sentinel int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(int)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be integer", k))
+			return
+		}
+
+		if value == sentinel || (value >= min && value <= max) {
+			return
+		}
+
+		errors = append(errors, fmt.Errorf("expected %s to be in the range (%d - %d), or %d to indicate no limit, got %d", k, min, max, sentinel, value))
+
+		return
+	}
+}
+
+// graphQLTypeDefinitionRegexp matches GraphQL SDL object type definitions, e.g. "type Query".
+var graphQLTypeDefinitionRegexp = regexache.MustCompile(`(?m)^\s*(?:extend\s+)?type\s+[_A-Za-z][_0-9A-Za-z]*`)
+
+// ValidGraphQLSchema validates that a string value looks like a GraphQL schema definition (SDL), e.g. for an
+// AppSync API. This is a lightweight check, not a full parse: brackets must be balanced, ignoring those within
+// comments and strings, and at least one object type must be defined.
+func ValidGraphQLSchema(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	sdl, err := graphQLStripCommentsAndStrings(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid GraphQL schema: %s", k, err))
+		return
+	}
+
+	type bracket struct {
+		r    rune
+		line int
+	}
+	var (
+		line  = 1
+		stack []bracket
+	)
+	pairs := map[rune]rune{'}': '{', ')': '(', ']': '['}
+
+	for _, r := range sdl {
+		switch r {
+		case '\n':
+			line++
+		case '{', '(', '[':
+			stack = append(stack, bracket{r, line})
+		case '}', ')', ']':
+			if len(stack) == 0 || stack[len(stack)-1].r != pairs[r] {
+				errors = append(errors, fmt.Errorf("%q contains an invalid GraphQL schema: unexpected %q on line %d", k, r, line))
+				return
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if len(stack) > 0 {
+		b := stack[len(stack)-1]
+		errors = append(errors, fmt.Errorf("%q contains an invalid GraphQL schema: unclosed %q opened on line %d", k, b.r, b.line))
+		return
+	}
+
+	if !graphQLTypeDefinitionRegexp.MatchString(sdl) {
+		errors = append(errors, fmt.Errorf("%q contains an invalid GraphQL schema: no type definitions found", k))
+	}
+
+	return
+}
+
+// graphQLStripCommentsAndStrings returns the specified GraphQL document with the contents of comments,
+// strings and block strings replaced by spaces, preserving line breaks.
+func graphQLStripCommentsAndStrings(s string) (string, error) {
+	var sb strings.Builder
+	blank := func(s string) {
+		sb.WriteString(strings.Map(func(r rune) rune {
+			if r == '\n' {
+				return r
+			}
+			return ' '
+		}, s))
+	}
+
+	for len(s) > 0 {
+		switch {
+		case s[0] == '#':
+			n := strings.IndexByte(s, '\n')
+			if n == -1 {
+				n = len(s)
+			}
+			blank(s[:n])
+			s = s[n:]
+		case strings.HasPrefix(s, `"""`):
+			n := strings.Index(s[3:], `"""`)
+			if n == -1 {
+				return "", fmt.Errorf("unterminated block string")
+			}
+			blank(s[:n+6])
+			s = s[n+6:]
+		case s[0] == '"':
+			n := 1
+			for ; n < len(s) && s[n] != '"' && s[n] != '\n'; n++ {
+				if s[n] == '\\' {
+					n++
+				}
+			}
+			if n >= len(s) || s[n] != '"' {
+				return "", fmt.Errorf("unterminated string")
+			}
+			blank(s[:n+1])
+			s = s[n+1:]
+		default:
+			sb.WriteByte(s[0])
+			s = s[1:]
+		}
+	}
+
+	return sb.String(), nil
+}
+
+var cloudFrontPathPatternRegexp = regexache.MustCompile(`^[0-9A-Za-z_.*$/~"'@:+&?-]+$`)
+
+// ValidCloudFrontPathPattern validates that a string value is a CloudFront cache behavior path pattern, e.g.
+// "/images/*" or "*.jpg": at most 255 allowed characters with at most one "*" wildcard, at the start or end.
+// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/distribution-web-values-specify.html#DownloadDistValuesPathPattern.
+func ValidCloudFrontPathPattern(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if len(value) < 1 {
+		errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+		return
+	}
+	if len(value) > 255 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 255 characters", k))
+	}
+	if !cloudFrontPathPatternRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q (%s) can only contain letters, numbers and the characters _-.*$/~\"'@:+&?", k, value))
+	}
+
+	if n := strings.Count(value, "*"); n > 1 {
+		errors = append(errors, fmt.Errorf("%q (%s) can contain at most one \"*\" wildcard, got %d", k, value, n))
+	} else if i := strings.Index(value, "*"); i > 0 && i < len(value)-1 {
+		errors = append(errors, fmt.Errorf("%q (%s) can only contain a \"*\" wildcard at the start or end of the pattern", k, value))
+	}
+
+	return
+}
+
+var openSearchDomainNameRegexp = regexache.MustCompile(`^[0-9a-z-]+$`)
+
+// ValidOpenSearchDomainName validates that a string value is a valid OpenSearch or Elasticsearch domain name:
+// 3 to 28 lowercase alphanumeric characters or hyphens, beginning with a letter.
+func ValidOpenSearchDomainName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 3 {
+		errors = append(errors, fmt.Errorf("%q (%s) cannot be shorter than 3 characters", k, value))
+		return
+	}
+	if len(value) > 28 {
+		errors = append(errors, fmt.Errorf("%q (%s) cannot be longer than 28 characters", k, value))
+		return
+	}
+	if strings.ToLower(value) != value {
+		errors = append(errors, fmt.Errorf("%q (%s) cannot contain uppercase characters", k, value))
+	} else if !openSearchDomainNameRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("only lowercase alphanumeric characters and hyphens allowed in %q (%s)", k, value))
+	}
+	if !unicode.IsLetter(rune(value[0])) {
+		errors = append(errors, fmt.Errorf("first character of %q (%s) must be a letter", k, value))
+	}
+
+	return
+}
+
+// ValidTimeZone validates that a string value is an IANA time zone name, e.g. "America/New_York" or "UTC".
+// Legacy names without a region, e.g. "EST" or "Japan", are accepted with a warning as they are deprecated
+// and often represent a fixed offset that does not observe daylight saving time.
+func ValidTimeZone(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// time.LoadLocation maps "" to UTC and "Local" to the system time zone.
+	if value == "" || value == "Local" {
+		errors = append(errors, fmt.Errorf("%q (%s) must be an IANA time zone name, e.g. \"America/New_York\"", k, value))
+		return
+	}
+
+	if _, err := time.LoadLocation(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) must be an IANA time zone name, e.g. \"America/New_York\": %s", k, value, err))
+		return
+	}
+
+	if value != "UTC" && !strings.Contains(value, "/") {
+		ws = append(ws, fmt.Sprintf("%q (%s) is a deprecated time zone name, use a region-based name such as \"America/New_York\" instead", k, value))
+	}
+
+	return
+}
+
+// LogRetentionDays are the CloudWatch Logs retention periods, in days. 0 means that log events never expire.
+// See https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutRetentionPolicy.html.
+var LogRetentionDays = []int{0, 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653}
+
+// ValidLogRetentionDays validates that an integer value is a CloudWatch Logs retention period.
+// Invalid values are reported with the nearest retention period, preferring the longer one if equidistant.
+func ValidLogRetentionDays(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be integer", k))
+		return
+	}
+
+	if slices.Contains(LogRetentionDays, value) {
+		return
+	}
+
+	distance := func(days int) int {
+		if days > value {
+			return days - value
+		}
+		return value - days
+	}
+
+	// Skip 0 (never expire) when looking for the nearest period.
+	nearest := LogRetentionDays[1]
+	for _, days := range LogRetentionDays[2:] {
+		if distance(days) <= distance(nearest) {
+			nearest = days
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("expected %s to be one of %v, got %d; did you mean %d?", k, LogRetentionDays, value, nearest))
+
+	return
+}
+
+// ValidGrowthFactor validates that a float value is a valid AppConfig deployment strategy growth factor,
+// the percentage of targets to receive a deployed configuration during each interval, between 1.0 and 100.0 inclusive.
+func ValidGrowthFactor(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(float64)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be float", k))
+		return
+	}
+
+	if value < 1 || value > 100 {
+		errors = append(errors, fmt.Errorf("%q (%g) must be between 1.0 and 100.0", k, value))
+	}
+
+	return
+}
+
+// ValidDeploymentDurationMinutes validates that an integer value is a valid AppConfig deployment strategy
+// deployment duration or final bake time, between 0 and 1440 minutes (24 hours) inclusive.
+func ValidDeploymentDurationMinutes(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be integer", k))
+		return
+	}
+
+	if value < 0 || value > 1440 {
+		errors = append(errors, fmt.Errorf("%q (%d) must be between 0 and 1440 minutes", k, value))
+	}
+
+	return
+}
+
+const (
+	wafCapacityMin = 0
+	wafCapacityMax = 5000
+)
+
+// ValidWAFCapacity validates that an integer value is a WAFv2 capacity in web ACL capacity units (WCUs),
+// between 0 and 5000 inclusive, the maximum for a web ACL or rule group.
+// See https://docs.aws.amazon.com/waf/latest/developerguide/aws-waf-capacity-units.html.
+func ValidWAFCapacity(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be integer", k))
+		return
+	}
+
+	if value < wafCapacityMin || value > wafCapacityMax {
+		errors = append(errors, fmt.Errorf("%q (%d) must be between %d and %d WCUs", k, value, wafCapacityMin, wafCapacityMax))
+	}
+
+	return
+}
+
+// ValidBeanstalkApplicationName validates that a string value is a valid Elastic Beanstalk application name:
+// 1 to 100 characters, not including forward slashes.
+func ValidBeanstalkApplicationName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if n := utf8.RuneCountInString(value); n < 1 {
+		errors = append(errors, fmt.Errorf("%q cannot be shorter than 1 character", k))
+	} else if n > 100 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 100 characters", k))
+	} else if strings.Contains(value, "/") {
+		errors = append(errors, fmt.Errorf("%q (%s) cannot contain a forward slash (/)", k, value))
+	}
+
+	return
+}
+
+var beanstalkEnvironmentNameRegexp = regexache.MustCompile(`^[0-9A-Za-z-]+$`)
+
+// ValidBeanstalkEnvironmentName validates that a string value is a valid Elastic Beanstalk environment name:
+// 4 to 40 alphanumeric characters or hyphens, not beginning or ending with a hyphen.
+func ValidBeanstalkEnvironmentName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 4 {
+		errors = append(errors, fmt.Errorf("%q (%s) cannot be shorter than 4 characters", k, value))
+	} else if len(value) > 40 {
+		errors = append(errors, fmt.Errorf("%q (%s) cannot be longer than 40 characters", k, value))
+	}
+	if !beanstalkEnvironmentNameRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("only alphanumeric characters and hyphens allowed in %q (%s)", k, value))
+	}
+	if strings.HasPrefix(value, "-") || strings.HasSuffix(value, "-") {
+		errors = append(errors, fmt.Errorf("%q (%s) cannot begin or end with a hyphen", k, value))
+	}
+
+	return
+}
+
+// ValidCloudTrailKeyPrefix validates that a string value is a valid CloudTrail S3 key prefix: at most 200
+// characters from the S3 object key safe character set, e.g. "cloudtrail/logs", not beginning with a slash.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-keys.html#object-key-guidelines-safe-characters.
+func ValidCloudTrailKeyPrefix(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if len(value) > 200 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 200 characters, got %d", k, len(value)))
+	}
+	if strings.HasPrefix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q (%s) cannot begin with a slash", k, value))
+	}
+	if i := strings.IndexFunc(value, func(r rune) bool {
+		return !('0' <= r && r <= '9' || 'A' <= r && r <= 'Z' || 'a' <= r && r <= 'z' || strings.ContainsRune("!-_.*'()/", r))
+	}); i != -1 {
+		r, _ := utf8.DecodeRuneInString(value[i:])
+		errors = append(errors, fmt.Errorf("%q (%s) contains invalid character %q at offset %d; only alphanumeric characters and !-_.*'()/ are allowed", k, value, r, i))
+	}
+
+	return
+}
+
+const (
+	dxVLANMin = 1
+	dxVLANMax = 4094
+)
+
+// ValidDXVLAN validates that an integer value is a valid Direct Connect virtual interface VLAN ID,
+// between 1 and 4094 inclusive. VLAN IDs 0 and 4095 are reserved by IEEE 802.1Q.
+func ValidDXVLAN(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be integer", k))
+		return
+	}
+
+	if value < dxVLANMin || value > dxVLANMax {
+		errors = append(errors, fmt.Errorf("%q (%d) must be between %d and %d; VLAN IDs 0 and 4095 are reserved", k, value, dxVLANMin, dxVLANMax))
+	}
+
+	return
+}
+
+// ValidJMESPath validates that a string value is a JMESPath expression that compiles, e.g. "Items[?Status=='ACTIVE'].Name".
+// The offset of a syntax error within the expression is reported.
+func ValidJMESPath(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := jmespath.Compile(value); err != nil {
+		if serr, ok := errs.As[jmespath.SyntaxError](err); ok {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a valid JMESPath expression: %s at offset %d", k, value, strings.TrimPrefix(serr.Error(), "SyntaxError: "), serr.Offset))
+			return
+		}
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid JMESPath expression: %w", k, value, err))
+	}
+
+	return
+}
@@ -4,13 +4,18 @@
 package verify
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"golang.org/x/exp/slices"
 )
 
 func TestValidAmazonSideASN(t *testing.T) {
@@ -145,6 +150,87 @@ func TestValidTypeStringNullableFloat(t *testing.T) {
 	}
 }
 
+func TestValidSpotPrice(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val: "",
+		},
+		{
+			val: "0.05",
+		},
+		{
+			val: "1",
+		},
+		{
+			val: "0.031200",
+		},
+		{
+			val:         "0",
+			expectedErr: regexache.MustCompile(`spot price \(0\) must be greater than 0`),
+		},
+		{
+			val:         "-1",
+			expectedErr: regexache.MustCompile(`spot price \(-1\) must be greater than 0`),
+		},
+		{
+			val:         "abc",
+			expectedErr: regexache.MustCompile(`cannot parse`),
+		},
+		{
+			val:         "0.0000001",
+			expectedErr: regexache.MustCompile(`spot price \(0.0000001\) cannot have more than 6 decimal places`),
+		},
+		{
+			val:         "NaN",
+			expectedErr: regexache.MustCompile(`spot price \(NaN\) must be a finite number`),
+		},
+		{
+			val:         "Inf",
+			expectedErr: regexache.MustCompile(`spot price \(Inf\) must be a finite number`),
+		},
+		{
+			val:         "+Inf",
+			expectedErr: regexache.MustCompile(`spot price \(\+Inf\) must be a finite number`),
+		},
+		{
+			val:         "1e-3",
+			expectedErr: regexache.MustCompile(`spot price \(1e-3\) must be a decimal number`),
+		},
+		{
+			val:         "0x1p-2",
+			expectedErr: regexache.MustCompile(`spot price \(0x1p-2\) must be a decimal number`),
+		},
+		{
+			val:         "+0.05",
+			expectedErr: regexache.MustCompile(`spot price \(\+0.05\) must be a decimal number`),
+		},
+		{
+			val:         0.05,
+			expectedErr: regexache.MustCompile(`expected type of test_property to be string`),
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := ValidSpotPrice(tc.val, "test_property")
+
+		if tc.expectedErr == nil {
+			if len(errs) != 0 {
+				t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+			}
+			continue
+		}
+
+		if len(errs) != 1 || !tc.expectedErr.MatchString(errs[0].Error()) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
+
 func TestValidAccountID(t *testing.T) {
 	t.Parallel()
 
@@ -224,6 +310,296 @@ func TestValidARN(t *testing.T) {
 	}
 }
 
+func TestParseARN(t *testing.T) {
+	t.Parallel()
+
+	validARNs := []string{
+		"arn:aws:iam::123456789012:user/David",                            // lintignore:AWSAT005
+		"arn:aws:rds:eu-west-1:123456789012:db:mysql-db",                  // lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:ec2:us-gov-west-1:123456789012:instance/i-123456", // lintignore:AWSAT003,AWSAT005
+		"arn:aws:s3:::bucket/object",                                      // lintignore:AWSAT005
+	}
+	for _, v := range validARNs {
+		if _, errors := ValidARN(v, "arn"); len(errors) != 0 {
+			t.Fatalf("%q should be a valid ARN: %q", v, errors)
+		}
+
+		a, err := ParseARN(v)
+		if err != nil {
+			t.Fatalf("%q should parse as an ARN: %s", v, err)
+		}
+		if got := a.String(); got != v {
+			t.Fatalf("%q: expected parsed ARN %q, got %q", v, v, got)
+		}
+	}
+
+	a, err := ParseARN("arn:aws:rds:eu-west-1:123456789012:db:mysql-db") // lintignore:AWSAT003,AWSAT005
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Partition != "aws" || a.Service != "rds" || a.Region != "eu-west-1" || a.AccountID != "123456789012" || a.Resource != "db:mysql-db" { // lintignore:AWSAT003
+		t.Fatalf("unexpected ARN components: %#v", a)
+	}
+
+	invalidARNs := []string{
+		"",
+		"arn",
+		"123456789012",
+		"arn:aws",
+		"arn:aws:logs",                         //lintignore:AWSAT005
+		"arn:aws:logs:region:*:*",              //lintignore:AWSAT005
+		"arn:aws:iam::123456789012:",           //lintignore:AWSAT005
+		"arn:AWS:iam::123456789012:user/David", //lintignore:AWSAT005
+		"arn:aws:iam::1234:user/David",         //lintignore:AWSAT005
+		"arn::iam::123456789012:user/David",    //lintignore:AWSAT005
+		"arn:aws:ec2:us-east-1a:123456789012:vpc/vpc", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidARNs {
+		if _, errors := ValidARN(v, "arn"); v != "" && len(errors) == 0 {
+			t.Fatalf("%q should be an invalid ARN", v)
+		}
+
+		_, err := ParseARN(v)
+		if err == nil {
+			t.Fatalf("%q should not parse as an ARN", v)
+		}
+
+		target, ok := errs.As[*InvalidARNError](err)
+		if !ok {
+			t.Fatalf("%q: expected *InvalidARNError, got %T", v, err)
+		}
+		if target.ARN != v || len(target.Reasons) == 0 {
+			t.Fatalf("%q: unexpected error %#v", v, target)
+		}
+	}
+
+	_, err = ParseARN("arn:aws:iam::1234:")                                                                                                                                               //lintignore:AWSAT005
+	if want := `"arn:aws:iam::1234:" is an invalid ARN: invalid account ID value`; !strings.HasPrefix(err.Error(), want) || !strings.HasSuffix(err.Error(), "; missing resource value") { //lintignore:AWSAT005
+		t.Fatalf("expected error starting with %q and listing all reasons, got %q", want, err)
+	}
+}
+
+func TestValidARNOrWildcard(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"*",
+		"arn:aws:iam::123456789012:user/David", // lintignore:AWSAT005
+		"arn:aws:s3:::my_corporate_bucket/exampleobject.png", // lintignore:AWSAT005
+	}
+	for _, v := range validNames {
+		_, errors := ValidARNOrWildcard(v, "arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid ARN or wildcard: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"**",
+		"arn:*",
+		"arn:aws:logs", //lintignore:AWSAT005
+		"123456789012",
+	}
+	for _, v := range invalidNames {
+		_, errors := ValidARNOrWildcard(v, "arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid ARN or wildcard", v)
+		}
+	}
+}
+
+func TestValidACMCertificateARN(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value          string
+		RequiredRegion string
+		Valid          bool
+	}{
+		{"arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012", "us-east-1", true},  // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012", "", true},           // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012", "us-east-1", false}, // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:iam::123456789012:server-certificate/example", "", false},                                         // lintignore:AWSAT005
+		{"arn:aws:iam::123456789012:server-certificate/example", "us-east-1", false},                                // lintignore:AWSAT005
+		{"certificate/12345678-1234-1234-1234-123456789012", "", false},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidACMCertificateARN(tc.RequiredRegion)(tc.Value, "certificate_arn")
+		if tc.Valid && len(errors) != 0 {
+			t.Fatalf("%q (required region %q) should be a valid ACM certificate ARN: %q", tc.Value, tc.RequiredRegion, errors)
+		}
+		if !tc.Valid && len(errors) == 0 {
+			t.Fatalf("%q (required region %q) should be an invalid ACM certificate ARN", tc.Value, tc.RequiredRegion)
+		}
+	}
+}
+
+func TestValidARNAccountMatches(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value          string
+		OtherAccountID string
+		Valid          bool
+	}{
+		{"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", "123456789012", true},  // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", "210987654321", false}, // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", "", true},              // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:s3:::my-bucket", "123456789012", true},                                                       // lintignore:AWSAT005
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidARNCheck(ValidARNAccountMatches(tc.OtherAccountID))(tc.Value, "kms_key_arn")
+		if tc.Valid && len(errors) != 0 {
+			t.Fatalf("%q (account %q) should be a valid ARN: %q", tc.Value, tc.OtherAccountID, errors)
+		}
+		if !tc.Valid && len(errors) == 0 {
+			t.Fatalf("%q (account %q) should be an invalid ARN", tc.Value, tc.OtherAccountID)
+		}
+	}
+
+	_, errors := ValidARNCheck(ValidARNAccountMatches("210987654321"))("arn:aws:kms:us-west-2:123456789012:key/example", "kms_key_arn") // lintignore:AWSAT003,AWSAT005
+	if !strings.Contains(errors[0].Error(), `expected account ID "210987654321", got "123456789012"`) {
+		t.Fatalf("expected account ID error, got %q", errors[0])
+	}
+}
+
+func TestValidARNResourceDelimiter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value string
+		Delim string
+		Valid bool
+	}{
+		{"arn:aws:lambda:us-west-2:123456789012:function:my-function", ":", true},       // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:lambda:us-west-2:123456789012:function:my-function:prod", ":", true},  // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:lambda:us-west-2:123456789012:function/my-function", ":", false},      // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:ec2:us-west-2:123456789012:instance/i-1234567890abcdef0", "/", true},  // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:ec2:us-west-2:123456789012:instance:i-1234567890abcdef0", "/", false}, // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:s3:::my-bucket/path/to/object", "/", true},                            // lintignore:AWSAT005
+		{"arn:aws:s3:::my-bucket", "/", true},                                           // lintignore:AWSAT005
+		{"arn:aws:s3:::my-bucket", ":", true},                                           // lintignore:AWSAT005
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidARNCheck(ValidARNResourceDelimiter(tc.Delim))(tc.Value, "arn")
+		if tc.Valid && len(errors) != 0 {
+			t.Fatalf("%q (delimiter %q) should be a valid ARN: %q", tc.Value, tc.Delim, errors)
+		}
+		if !tc.Valid && len(errors) == 0 {
+			t.Fatalf("%q (delimiter %q) should be an invalid ARN", tc.Value, tc.Delim)
+		}
+	}
+}
+
+func TestValidARNFullyQualified(t *testing.T) {
+	t.Parallel()
+
+	validARNs := []string{
+		"arn:aws:sns:us-west-2:123456789012:example",                                  // lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:lambda:us-gov-west-1:123456789012:function:example",           // lintignore:AWSAT003,AWSAT005
+		"arn:aws:iam::123456789012:role/example",                                      // lintignore:AWSAT005
+		"arn:aws:s3:::example-bucket",                                                 // lintignore:AWSAT005
+		"arn:aws:s3:us-west-2:123456789012:accesspoint/example",                       // lintignore:AWSAT003,AWSAT005
+		"arn:aws:route53:::hostedzone/Z1D633PJN98FT9",                                 // lintignore:AWSAT005
+		"arn:aws:cloudfront::123456789012:distribution/EDFDVBD6EXAMPLE",               // lintignore:AWSAT005
+		"arn:aws:organizations::123456789012:account/o-a1b2c3d4e5/555555555555",       // lintignore:AWSAT005
+		"arn:aws:sts::123456789012:assumed-role/example/session",                      // lintignore:AWSAT005
+		"arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd", // lintignore:AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := ValidARNFullyQualified(v, "arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a fully qualified ARN: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"arn:aws:sns::123456789012:example", `missing region value, required for service "sns"`},  // lintignore:AWSAT005
+		{"arn:aws:sns:us-west-2::example", `missing account ID value, required for service "sns"`}, // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:iam:::role/example", `missing account ID value, required for service "iam"`},     // lintignore:AWSAT005
+		{"arn::sns:us-west-2:123456789012:example", `missing partition value`},                     // lintignore:AWSAT003,AWSAT005
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidARNFullyQualified(tc.Value, "arn")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+
+	_, errors := ValidARNFullyQualified("arn:aws:sns:::example", "arn") // lintignore:AWSAT005
+	if len(errors) != 2 {
+		t.Fatalf("expected missing region and account ID errors, got %d: %q", len(errors), errors)
+	}
+}
+
+func TestValidDMSEndpointARN(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"arn:aws:dms:us-west-2:123456789012:endpoint:ABCDEFGHIJKLMNOPQRSTUVWXYZ", ""},                                        // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:dms:us-west-2:123456789012:rep:ABCDEFGHIJKLMNOPQRSTUVWXYZ", `expected resource type "endpoint", got "rep"`}, // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:rds:us-west-2:123456789012:endpoint:ABCDEFGHIJKLMNOPQRSTUVWXYZ", `expected service "dms", got "rds"`},       // lintignore:AWSAT003,AWSAT005
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidDMSEndpointARN(tc.Value, "endpoint_arn")
+		if tc.ExpectedErrSubstr == "" {
+			if len(errors) != 0 {
+				t.Fatalf("%q should be a valid DMS endpoint ARN: %q", tc.Value, errors)
+			}
+			continue
+		}
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidDMSReplicationInstanceARN(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"arn:aws:dms:us-west-2:123456789012:rep:ABCDEFGHIJKLMNOPQRSTUVWXYZ", ""},                                                  // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:dms:us-west-2:123456789012:endpoint:ABCDEFGHIJKLMNOPQRSTUVWXYZ", `expected resource type "rep", got "endpoint"`}, // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:dms:us-west-2:123456789012:task:ABCDEFGHIJKLMNOPQRSTUVWXYZ", `expected resource type "rep", got "task"`},         // lintignore:AWSAT003,AWSAT005
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidDMSReplicationInstanceARN(tc.Value, "replication_instance_arn")
+		if tc.ExpectedErrSubstr == "" {
+			if len(errors) != 0 {
+				t.Fatalf("%q should be a valid DMS replication instance ARN: %q", tc.Value, errors)
+			}
+			continue
+		}
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
 func TestValidCIDRNetworkAddress(t *testing.T) {
 	t.Parallel()
 
@@ -309,60 +685,265 @@ func TestValidIPv6CIDRBlock(t *testing.T) {
 	}
 }
 
-func TestIsIPv4CIDRBlockOrIPv6CIDRBlock(t *testing.T) {
+func TestValidVPCCIDRBlock(t *testing.T) {
 	t.Parallel()
 
-	validator := IsIPv4CIDRBlockOrIPv6CIDRBlock(
-		validation.IsCIDRNetwork(16, 24),
-		validation.IsCIDRNetwork(40, 64),
-	)
-	validCIDRs := []string{
-		"10.0.0.0/16", // IPv4 CIDR /16 >= /16 and <= /24
-		"10.0.0.0/23", // IPv4 CIDR /23 >= /16 and <= /24
-		"10.0.0.0/24", // IPv4 CIDR /24 >= /16 and <= /24
-		"2001::/40",   // IPv6 CIDR /40 >= /40 and <= /64
-		"2001::/63",   // IPv6 CIDR /63 >= /40 and <= /64
-		"2001::/64",   // IPv6 CIDR /64 >= /40 and <= /64
-	}
-
-	for _, v := range validCIDRs {
-		_, errors := validator(v, "cidr_block")
-		if len(errors) != 0 {
-			t.Fatalf("%q should be a valid CIDR block: %q", v, errors)
+	for _, ts := range []struct {
+		cidr  string
+		valid bool
+	}{
+		{"10.0.0.0/8", false},
+		{"10.0.0.0/15", false},
+		{"10.0.0.0/16", true},
+		{"10.0.1.0/24", true},
+		{"10.0.1.0/28", true},
+		{"10.0.1.0/29", false},
+		{"10.0.1.0/30", false},
+		{"10.0.1.1/24", false},
+		{"2001:db8::/56", false},
+		{"", false},
+	} {
+		_, errors := ValidVPCCIDRBlock(ts.cidr, "cidr_block")
+		if !ts.valid && len(errors) == 0 {
+			t.Fatalf("Input '%s' should error but didn't!", ts.cidr)
+		}
+		if ts.valid && len(errors) != 0 {
+			t.Fatalf("Got unexpected error for '%s' input: %s", ts.cidr, errors)
 		}
 	}
+}
 
-	invalidCIDRs := []string{
-		"ASDQWE",      // not IPv4 nor IPv6 CIDR
-		"0.0.0.0/0",   // IPv4 CIDR /0 < /16
-		"10.0.0.0/8",  // IPv4 CIDR /8 < /16
-		"10.0.0.1/24", // IPv4 CIDR with invalid network part
-		"10.0.0.0/25", // IPv4 CIDR /25 > /24
-		"10.0.0.0/32", // IPv4 CIDR /32 > /24
-		"::/0",        // IPv6 CIDR /0 < /40
-		"2001::/30",   // IPv6 CIDR /30 < /40
-		"2001::1/64",  // IPv6 CIDR with invalid network part
-		"2001::/65",   // IPv6 CIDR /65 > /64
-		"2001::/128",  // IPv6 CIDR /128 > /64
-	}
+func TestValidVPCIPv6CIDRBlock(t *testing.T) {
+	t.Parallel()
 
-	for _, v := range invalidCIDRs {
-		_, errors := validator(v, "cidr_block")
-		if len(errors) == 0 {
-			t.Fatalf("%q should be an invalid CIDR block", v)
+	for _, ts := range []struct {
+		cidr  string
+		valid bool
+	}{
+		{"2001:db8::/56", true},
+		{"2001:db8::/48", false},
+		{"2001:db8::/64", false},
+		{"2001:db8::1/56", false},
+		{"10.0.0.0/16", false},
+		{"", false},
+	} {
+		_, errors := ValidVPCIPv6CIDRBlock(ts.cidr, "ipv6_cidr_block")
+		if !ts.valid && len(errors) == 0 {
+			t.Fatalf("Input '%s' should error but didn't!", ts.cidr)
+		}
+		if ts.valid && len(errors) != 0 {
+			t.Fatalf("Got unexpected error for '%s' input: %s", ts.cidr, errors)
 		}
 	}
 }
 
-func TestValidIAMPolicyJSONString(t *testing.T) {
+func TestIPv4CIDRCanHostSubnets(t *testing.T) {
 	t.Parallel()
 
-	type testCases struct {
-		Value     string
-		WantError string
+	cases := []struct {
+		CIDR              string
+		SubnetBits        int
+		Count             int
+		ExpectedErrSubstr string
+	}{
+		{"10.0.0.0/16", 8, 256, ""},
+		{"10.0.0.0/16", 8, 512, `can hold 256 /24 subnets, at least 512 are required`},
+		{"10.0.0.0/16", 0, 1, ""},
+		{"10.0.0.0/28", 4, 16, ""},
+		{"10.0.0.0/28", 5, 1, `cannot be divided into /33 subnets`},
+		{"10.0.0.0/16", -1, 1, `"cidr_block": subnet prefix length increase (-1) must not be negative`},
+		{"10.0.0.1/16", 8, 1, `is not a valid IPv4 CIDR block; did you mean`},
+		{"2001:db8::/56", 8, 1, `is not a valid IPv4 CIDR block`},
 	}
-	tests := []testCases{
-		{
+
+	for _, tc := range cases {
+		_, errors := IPv4CIDRCanHostSubnets(tc.SubnetBits, tc.Count)(tc.CIDR, "cidr_block")
+		if tc.ExpectedErrSubstr == "" {
+			if len(errors) != 0 {
+				t.Fatalf("%s (%d, %d): expected no error, got %q", tc.CIDR, tc.SubnetBits, tc.Count, errors)
+			}
+			continue
+		}
+		if len(errors) != 1 {
+			t.Fatalf("%s (%d, %d): expected 1 error, got %d: %q", tc.CIDR, tc.SubnetBits, tc.Count, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%s (%d, %d): expected error %q to include %q", tc.CIDR, tc.SubnetBits, tc.Count, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestIPv4SubnetUsableCapacity(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		CIDR              string
+		Expected          int
+		ExpectedErrSubstr string
+	}{
+		{"10.0.0.0/16", 65531, ""},
+		{"10.0.0.0/24", 251, ""},
+		{"10.0.0.0/28", 11, ""},
+		{"10.0.0.0/29", 0, `is smaller than the minimum subnet size, /28`},
+		{"10.0.0.1/24", 0, `is not a valid IPv4 CIDR block`},
+		{"2001:db8::/64", 0, `is not a valid IPv4 CIDR block`},
+	}
+
+	for _, tc := range cases {
+		usable, err := IPv4SubnetUsableCapacity(tc.CIDR)
+		if tc.ExpectedErrSubstr == "" {
+			if err != nil {
+				t.Fatalf("%s: expected no error, got %s", tc.CIDR, err)
+			}
+			if usable != tc.Expected {
+				t.Fatalf("%s: expected %d usable IP addresses, got %d", tc.CIDR, tc.Expected, usable)
+			}
+			continue
+		}
+		if err == nil {
+			t.Fatalf("%s: expected error including %q, got none", tc.CIDR, tc.ExpectedErrSubstr)
+		}
+		if !strings.Contains(err.Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%s: expected error %q to include %q", tc.CIDR, err, tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidSubnetMinUsable(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		CIDR              string
+		Min               int
+		ExpectedErrSubstr string
+	}{
+		{"10.0.0.0/24", 251, ""},
+		{"10.0.0.0/24", 252, `"cidr_block" (10.0.0.0/24) has 251 usable IP addresses, at least 252 are required`},
+		{"10.0.0.0/28", 11, ""},
+		{"10.0.0.0/28", 16, `has 11 usable IP addresses, at least 16 are required`},
+		{"10.0.0.0/30", 1, `is smaller than the minimum subnet size, /28`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidSubnetMinUsable(tc.Min)(tc.CIDR, "cidr_block")
+		if tc.ExpectedErrSubstr == "" {
+			if len(errors) != 0 {
+				t.Fatalf("%s (%d): expected no error, got %q", tc.CIDR, tc.Min, errors)
+			}
+			continue
+		}
+		if len(errors) != 1 {
+			t.Fatalf("%s (%d): expected 1 error, got %d: %q", tc.CIDR, tc.Min, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%s (%d): expected error %q to include %q", tc.CIDR, tc.Min, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestCIDRBlocksCoverParent(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Parent            string
+		Children          []string
+		ExpectedCovered   bool
+		ExpectedUncovered []string
+		ExpectedErrSubstr string
+	}{
+		{"10.0.0.0/16", []string{"10.0.0.0/16"}, true, nil, ""},
+		{"10.0.0.0/16", []string{"10.0.128.0/17", "10.0.0.0/17"}, true, nil, ""},
+		{"10.0.0.0/24", []string{"10.0.0.0/26", "10.0.0.64/26", "10.0.0.128/25"}, true, nil, ""},
+		{"10.0.0.0/24", nil, false, []string{"10.0.0.0/24"}, ""},
+		{"10.0.0.0/24", []string{"10.0.0.0/26", "10.0.0.192/26"}, false, []string{"10.0.0.64/26", "10.0.0.128/26"}, ""},
+		{"10.0.0.0/24", []string{"10.0.0.16/28"}, false, []string{"10.0.0.0/28", "10.0.0.32/27", "10.0.0.64/26", "10.0.0.128/25"}, ""},
+		{"0.0.0.0/0", []string{"0.0.0.0/1"}, false, []string{"128.0.0.0/1"}, ""},
+		{"2001:db8::/56", []string{"2001:db8::/57"}, false, []string{"2001:db8:0:80::/57"}, ""},
+		{"10.0.0.0/24", []string{"10.0.0.0/25", "10.0.0.64/26"}, false, nil, `"10.0.0.64/26" overlaps "10.0.0.0/25"`},
+		{"10.0.0.0/24", []string{"10.0.0.0/25", "10.0.0.0/25"}, false, nil, `"10.0.0.0/25" overlaps "10.0.0.0/25"`},
+		{"10.0.0.0/24", []string{"10.0.1.0/25"}, false, nil, `"10.0.1.0/25" is not within "10.0.0.0/24"`},
+		{"10.0.0.0/24", []string{"10.0.0.0/23"}, false, nil, `"10.0.0.0/23" is not within "10.0.0.0/24"`},
+		{"10.0.0.0/24", []string{"2001:db8::/64"}, false, nil, `"2001:db8::/64" is not within "10.0.0.0/24"`},
+		{"10.0.0.1/24", nil, false, nil, `is not a valid CIDR block`},
+	}
+
+	for _, tc := range cases {
+		covered, uncovered, err := CIDRBlocksCoverParent(tc.Parent, tc.Children)
+		if tc.ExpectedErrSubstr != "" {
+			if err == nil {
+				t.Fatalf("%s %q: expected error including %q, got none", tc.Parent, tc.Children, tc.ExpectedErrSubstr)
+			}
+			if !strings.Contains(err.Error(), tc.ExpectedErrSubstr) {
+				t.Fatalf("%s %q: expected error %q to include %q", tc.Parent, tc.Children, err, tc.ExpectedErrSubstr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s %q: expected no error, got %s", tc.Parent, tc.Children, err)
+		}
+		if covered != tc.ExpectedCovered {
+			t.Fatalf("%s %q: expected covered %t, got %t", tc.Parent, tc.Children, tc.ExpectedCovered, covered)
+		}
+		if !slices.Equal(uncovered, tc.ExpectedUncovered) {
+			t.Fatalf("%s %q: expected uncovered %q, got %q", tc.Parent, tc.Children, tc.ExpectedUncovered, uncovered)
+		}
+	}
+}
+
+func TestIsIPv4CIDRBlockOrIPv6CIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	validator := IsIPv4CIDRBlockOrIPv6CIDRBlock(
+		validation.IsCIDRNetwork(16, 24),
+		validation.IsCIDRNetwork(40, 64),
+	)
+	validCIDRs := []string{
+		"10.0.0.0/16", // IPv4 CIDR /16 >= /16 and <= /24
+		"10.0.0.0/23", // IPv4 CIDR /23 >= /16 and <= /24
+		"10.0.0.0/24", // IPv4 CIDR /24 >= /16 and <= /24
+		"2001::/40",   // IPv6 CIDR /40 >= /40 and <= /64
+		"2001::/63",   // IPv6 CIDR /63 >= /40 and <= /64
+		"2001::/64",   // IPv6 CIDR /64 >= /40 and <= /64
+	}
+
+	for _, v := range validCIDRs {
+		_, errors := validator(v, "cidr_block")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CIDR block: %q", v, errors)
+		}
+	}
+
+	invalidCIDRs := []string{
+		"ASDQWE",      // not IPv4 nor IPv6 CIDR
+		"0.0.0.0/0",   // IPv4 CIDR /0 < /16
+		"10.0.0.0/8",  // IPv4 CIDR /8 < /16
+		"10.0.0.1/24", // IPv4 CIDR with invalid network part
+		"10.0.0.0/25", // IPv4 CIDR /25 > /24
+		"10.0.0.0/32", // IPv4 CIDR /32 > /24
+		"::/0",        // IPv6 CIDR /0 < /40
+		"2001::/30",   // IPv6 CIDR /30 < /40
+		"2001::1/64",  // IPv6 CIDR with invalid network part
+		"2001::/65",   // IPv6 CIDR /65 > /64
+		"2001::/128",  // IPv6 CIDR /128 > /64
+	}
+
+	for _, v := range invalidCIDRs {
+		_, errors := validator(v, "cidr_block")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CIDR block", v)
+		}
+	}
+}
+
+func TestValidIAMPolicyJSONString(t *testing.T) {
+	t.Parallel()
+
+	type testCases struct {
+		Value     string
+		WantError string
+	}
+	tests := []testCases{
+		{
 			Value: `{}`,
 			// Valid
 		},
@@ -436,6 +1017,69 @@ func TestValidIAMPolicyJSONString(t *testing.T) {
 	}
 }
 
+func TestValidIAMPolicyPrincipals(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Name              string
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{
+			Name:  "no principal",
+			Value: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			Name:  "wildcard principal",
+			Value: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"*"}}`,
+		},
+		{
+			Name: "valid principals",
+			Value: `{"Version":"2012-10-17","Statement":[
+				{"Effect":"Allow","Principal":{"AWS":["123456789012","arn:aws:iam::123456789012:root","*"]},"Action":"s3:GetObject","Resource":"*"},
+				{"Effect":"Allow","Principal":{"Service":"lambda.amazonaws.com","Federated":"cognito-identity.amazonaws.com"},"Action":"sts:AssumeRole"},
+				{"Effect":"Deny","NotPrincipal":{"CanonicalUser":"79a59df900b949e55d96a1e698fbacedfd6e09d98eacf8f8d5218e7cd47ef2be"},"Action":"s3:*","Resource":"*"}
+			]}`,
+		},
+		{
+			Name:              "unknown principal type",
+			Value:             `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:*"},{"Effect":"Allow","Principal":{"User":"bob"},"Action":"s3:*"}]}`,
+			ExpectedErrSubstr: `statement 1: Principal has unrecognized principal type "User"`,
+		},
+		{
+			Name:              "malformed AWS principal",
+			Value:             `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"12345"},"Action":"s3:*"}]}`,
+			ExpectedErrSubstr: `statement 0: Principal AWS value "12345" must be an account ID, an ARN or "*"`,
+		},
+		{
+			Name:              "malformed NotPrincipal",
+			Value:             `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":["123456789012"],"Action":"s3:*"}]}`,
+			ExpectedErrSubstr: `statement 0: NotPrincipal must be "*" or an object`,
+		},
+		{
+			Name:              "invalid JSON",
+			Value:             `{"Version":"2012-10-17","Statement":[}`,
+			ExpectedErrSubstr: `contains an invalid JSON policy`,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errs := ValidIAMPolicyPrincipals(tc.Value, "policy")
+		if tc.ExpectedErrSubstr == "" {
+			if len(errs) != 0 {
+				t.Fatalf("%s: Expected no error, got errs: %q", tc.Name, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 {
+			t.Fatalf("%s: Expected 1 err containing %q, got %d errs: %q", tc.Name, tc.ExpectedErrSubstr, len(errs), errs)
+		}
+		if !strings.Contains(errs[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%s: Expected err: %q, to include %q", tc.Name, errs[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
 func TestValidStringIsJSONOrYAML(t *testing.T) {
 	t.Parallel()
 
@@ -481,6 +1125,152 @@ func TestValidStringIsJSONOrYAML(t *testing.T) {
 	}
 }
 
+func TestValidStrictJSON(t *testing.T) {
+	t.Parallel()
+
+	validValues := []string{
+		`{}`,
+		`{"a":1}`,
+		`  {"a":[1,2,3]}  `,
+		"{\"a\":\"b\"}\n",
+		`[]`,
+		`"string"`,
+	}
+	for _, v := range validValues {
+		_, errors := ValidStrictJSON(v, "json")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid strict JSON: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		``,
+		`{`,
+		`{} extra`,
+		`{}{}`,
+		`{"a":1} {"b":2}`,
+		`{}}`,
+		`[] ]`,
+	}
+	for _, v := range invalidValues {
+		_, errors := ValidStrictJSON(v, "json")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be invalid strict JSON", v)
+		}
+	}
+}
+
+func TestValidSingleYAMLDocument(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{
+		"key: value\n",
+		"---\nkey: value\nlist:\n  - a\n  - b\n",
+		"--- {\"key\": \"value\"}\n",
+		"key: value\n...\n",
+		"a: 1\n---\n",
+		"---\na: 1\n---\n",
+	} {
+		_, errors := ValidSingleYAMLDocument(v, "document")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a single YAML document: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"a: 1\n---\nb: 2\n", `"document" must contain a single YAML document, found 2`},
+		{"---\na: 1\n---\nb: 2\n---\nc: 3\n", `"document" must contain a single YAML document, found 3`},
+		{"a: [1, 2\n", `"document" contains an invalid YAML`},
+		{"", `"document" must contain a YAML document`},
+		{"---\n", `"document" must contain a YAML document`},
+		{"# comment\n", `"document" must contain a YAML document`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidSingleYAMLDocument(tc.Value, "document")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidJSONMaxSize(t *testing.T) {
+	t.Parallel()
+
+	f := ValidJSONMaxSize(32)
+	value := func(n int) string {
+		// {"a":"..."} is 8 bytes plus the string content.
+		return fmt.Sprintf(`{"a":"%s"}`, strings.Repeat("x", n-8))
+	}
+
+	for _, v := range []string{"{}", value(31), value(32)} {
+		_, errors := f(v, "body")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid JSON within the size limit: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{value(33), `"body" is 33 bytes long, the maximum is 32 bytes`},
+		{value(1024), `"body" is 1024 bytes long, the maximum is 32 bytes`},
+		{`{"a":`, `"body" contains an invalid JSON`},
+	}
+
+	for _, tc := range cases {
+		_, errors := f(tc.Value, "body")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidJSONArrayUniqueElements(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{
+		`[]`,
+		`["Content-Type", "Authorization"]`,
+		`[{"a": 1, "b": 2}, {"a": 2, "b": 1}, 1, "1"]`,
+	} {
+		_, errors := ValidJSONArrayUniqueElements(v, "allowed_headers")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a JSON array of unique elements: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{`["Content-Type", "Content-Type"]`, `"allowed_headers": element 1 is a duplicate of element 0`},
+		{`[{"a": 1, "b": [2, 3]}, 4, {"b": [2, 3], "a": 1}]`, `"allowed_headers": element 2 is a duplicate of element 0`},
+		{`{"a": 1}`, `"allowed_headers" must be a JSON array`},
+		{`[1,`, `"allowed_headers" must be a JSON array`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidJSONArrayUniqueElements(tc.Value, "allowed_headers")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
 func TestValidOnceAWeekWindowFormat(t *testing.T) {
 	t.Parallel()
 
@@ -674,6 +1464,87 @@ func TestValidUTCTimestamp(t *testing.T) {
 	}
 }
 
+func TestValidUTCTimestampInFutureOrPast(t *testing.T) { //nolint:paralleltest
+	setClock(t, time.Date(2023, time.September, 14, 12, 0, 0, 0, time.UTC))
+
+	past := "2023-09-13T12:00:00Z"
+	future := "2023-09-15T12:00:00Z"
+
+	for _, tc := range []struct {
+		name  string
+		f     schema.SchemaValidateFunc
+		value string
+		valid bool
+	}{
+		{"future/future", ValidUTCTimestampInFuture, future, true},
+		{"future/past", ValidUTCTimestampInFuture, past, false},
+		{"future/invalid", ValidUTCTimestampInFuture, "2023-09-15 12:00:00", false},
+		{"past/past", ValidUTCTimestampInPast, past, true},
+		{"past/future", ValidUTCTimestampInPast, future, false},
+		{"past/invalid", ValidUTCTimestampInPast, "27-03-2019 23:45:00", false},
+	} {
+		_, errors := tc.f(tc.value, "utc_timestamp")
+		if tc.valid && len(errors) > 0 {
+			t.Errorf("%s: expected %q to be valid, got error %q", tc.name, tc.value, errors)
+		} else if !tc.valid && len(errors) == 0 {
+			t.Errorf("%s: expected %q to fail validation", tc.name, tc.value)
+		}
+	}
+}
+
+func TestValidUTCTimestampFutureSkew(t *testing.T) { //nolint:paralleltest
+	setClock(t, time.Date(2023, time.September, 14, 12, 0, 0, 0, time.UTC))
+
+	recent := "2023-09-14T11:00:00Z"
+
+	if _, errors := ValidUTCTimestampFuture(0)(recent, "utc_timestamp"); len(errors) == 0 {
+		t.Errorf("expected %q to fail validation without skew", recent)
+	}
+	if _, errors := ValidUTCTimestampFuture(2*time.Hour)(recent, "utc_timestamp"); len(errors) > 0 {
+		t.Errorf("expected %q to be valid with skew, got error %q", recent, errors)
+	}
+
+	soon := "2023-09-14T13:00:00Z"
+
+	if _, errors := ValidUTCTimestampPast(0)(soon, "utc_timestamp"); len(errors) == 0 {
+		t.Errorf("expected %q to fail validation without skew", soon)
+	}
+	if _, errors := ValidUTCTimestampPast(2*time.Hour)(soon, "utc_timestamp"); len(errors) > 0 {
+		t.Errorf("expected %q to be valid with skew, got error %q", soon, errors)
+	}
+}
+
+func TestValidUTCTimestampInFutureOrPastBoundaries(t *testing.T) { //nolint:paralleltest
+	setClock(t, time.Date(2023, time.September, 14, 12, 0, 0, 0, time.UTC))
+
+	for _, tc := range []struct {
+		name  string
+		f     schema.SchemaValidateFunc
+		value string
+		valid bool
+	}{
+		{"future/now", ValidUTCTimestampInFuture, "2023-09-14T12:00:00Z", false},
+		{"future/one second later", ValidUTCTimestampInFuture, "2023-09-14T12:00:01Z", true},
+		{"future/one second earlier", ValidUTCTimestampInFuture, "2023-09-14T11:59:59Z", false},
+		{"future/offset", ValidUTCTimestampInFuture, "2023-09-14T08:00:01-04:00", true},
+		{"past/now", ValidUTCTimestampInPast, "2023-09-14T12:00:00Z", false},
+		{"past/one second earlier", ValidUTCTimestampInPast, "2023-09-14T11:59:59Z", true},
+		{"past/one second later", ValidUTCTimestampInPast, "2023-09-14T12:00:01Z", false},
+		{"past/offset", ValidUTCTimestampInPast, "2023-09-14T14:59:59+03:00", true},
+		{"future skew/at tolerance", ValidUTCTimestampFuture(time.Minute), "2023-09-14T11:59:00Z", false},
+		{"future skew/within tolerance", ValidUTCTimestampFuture(time.Minute), "2023-09-14T11:59:01Z", true},
+		{"past skew/at tolerance", ValidUTCTimestampPast(time.Minute), "2023-09-14T12:01:00Z", false},
+		{"past skew/within tolerance", ValidUTCTimestampPast(time.Minute), "2023-09-14T12:00:59Z", true},
+	} {
+		_, errors := tc.f(tc.value, "utc_timestamp")
+		if tc.valid && len(errors) > 0 {
+			t.Errorf("%s: expected %q to be valid, got error %q", tc.name, tc.value, errors)
+		} else if !tc.valid && len(errors) == 0 {
+			t.Errorf("%s: expected %q to fail validation", tc.name, tc.value)
+		}
+	}
+}
+
 func TestValidateTypeStringIsDateOrInt(t *testing.T) {
 	t.Parallel()
 
@@ -776,3 +1647,2483 @@ func TestValidServicePrincipal(t *testing.T) {
 		}
 	}
 }
+
+func TestMapKeysNoReservedPrefix(t *testing.T) {
+	t.Parallel()
+
+	f := MapKeysNoReservedPrefix("aws:")
+
+	runDiagTestCases(t, map[string]diagTestCase{
+		"empty": {
+			val: map[string]interface{}{},
+			f:   f,
+		},
+		"clean": {
+			val: map[string]interface{}{
+				"Name":        "test",
+				"Environment": "aws:prod",
+			},
+			f: f,
+		},
+		"reserved key": {
+			val: map[string]interface{}{
+				"Name":                        "test",
+				"aws:cloudformation:stack-id": "stack",
+			},
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value$`),
+			expectedDetail:  regexache.MustCompile(`Key "aws:cloudformation:stack-id" must not begin with reserved prefix "aws:"`),
+		},
+		"wrong type": {
+			val:             "aws:key",
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value type$`),
+			expectedDetail:  regexache.MustCompile(`^Expected type to be map$`),
+		},
+	})
+
+	diags := f(map[string]interface{}{
+		"aws:a": "1",
+		"aws:b": "2",
+		"c":     "3",
+	}, cty.Path{cty.GetAttrStep{Name: "tags"}})
+	if got, want := len(diags), 2; got != want {
+		t.Fatalf("expected %d diagnostics, got %d: %v", want, got, diags)
+	}
+	for i, k := range []string{"aws:a", "aws:b"} {
+		if want := (cty.Path{cty.GetAttrStep{Name: "tags"}}).IndexString(k); !diags[i].AttributePath.Equals(want) {
+			t.Errorf("expected diagnostic %d path %#v, got %#v", i, want, diags[i].AttributePath)
+		}
+	}
+}
+
+func TestMapMaxKeys(t *testing.T) {
+	t.Parallel()
+
+	tags := func(n int) map[string]interface{} {
+		m := make(map[string]interface{}, n)
+		for i := 0; i < n; i++ {
+			m[fmt.Sprintf("key%d", i)] = "value"
+		}
+		return m
+	}
+
+	runDiagTestCases(t, map[string]diagTestCase{
+		"empty": {
+			val: map[string]interface{}{},
+			f:   MapMaxKeys(2),
+		},
+		"at limit": {
+			val: tags(2),
+			f:   MapMaxKeys(2),
+		},
+		"over limit": {
+			val:             tags(3),
+			f:               MapMaxKeys(2),
+			expectedSummary: regexache.MustCompile(`^Invalid value$`),
+			expectedDetail:  regexache.MustCompile(`^Expected at most 2 keys, got 3$`),
+		},
+		"tags at limit": {
+			val: tags(50),
+			f:   TagMapWithinLimit,
+		},
+		"tags over limit": {
+			val:             tags(51),
+			f:               TagMapWithinLimit,
+			expectedSummary: regexache.MustCompile(`^Invalid value$`),
+			expectedDetail:  regexache.MustCompile(`^Expected at most 50 keys, got 51$`),
+		},
+		"wrong type": {
+			val:             []interface{}{"a"},
+			f:               TagMapWithinLimit,
+			expectedSummary: regexache.MustCompile(`^Invalid value type$`),
+			expectedDetail:  regexache.MustCompile(`^Expected type to be map$`),
+		},
+	})
+}
+
+func TestStringIsNotIPv4(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"my-bucket",
+		"my.bucket.name",
+		"192.168.1",
+		"192.168.1.1.1",
+		"192.168.1.1a",
+		"1.2.3.1234",
+		"::ffff:192.168.1.1",
+	}
+	for _, v := range validNames {
+		_, errors := StringIsNotIPv4(v, "bucket")
+		if len(errors) != 0 {
+			t.Fatalf("%q should not be validated as an IPv4 address: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"192.168.1.1",
+		"10.0.0.0",
+		"255.255.255.255",
+		"01.2.3.4",
+		"1.2.3.04",
+		"256.1.1.1",
+	}
+	for _, v := range invalidNames {
+		_, errors := StringIsNotIPv4(v, "bucket")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be validated as an IPv4 address", v)
+		}
+	}
+}
+
+func TestValidScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	validExpressions := []string{
+		"rate(1 minute)",
+		"rate(5 minutes)",
+		"rate(1 hour)",
+		"rate(12 hours)",
+		"rate(1 day)",
+		"rate(30 days)",
+		"cron(0 12 * * ? *)",
+		"cron(0/15 * * * ? *)",
+		"cron(0 16 1,15 * ? *)",
+	}
+	for _, v := range validExpressions {
+		_, errors := ValidScheduleExpression(v, "schedule_expression")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid schedule expression: %q", v, errors)
+		}
+	}
+
+	invalidExpressions := []string{
+		"",
+		"rate(0 minutes)",
+		"rate(1 minutes)",
+		"rate(5 minute)",
+		"rate(5 weeks)",
+		"rate(-1 days)",
+		"cron(0 12 * * ?)",
+		"cron(0 12 * * ? * *)",
+		"at(2023-01-01T00:00:00)",
+		"0 12 * * ? *",
+	}
+	for _, v := range invalidExpressions {
+		_, errors := ValidScheduleExpression(v, "schedule_expression")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid schedule expression", v)
+		}
+	}
+}
+
+func TestValidRotationScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	validExpressions := []string{
+		"rate(10 days)",
+		"rate(1 day)",
+		"rate(24 hours)",
+		"rate(1440 minutes)",
+		"cron(0 16 * * ? *)",
+		"cron(0 16 1,15 * ? *)",
+	}
+	for _, v := range validExpressions {
+		_, errors := ValidRotationScheduleExpression(v, "schedule_expression")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid rotation schedule expression: %q", v, errors)
+		}
+	}
+
+	invalidExpressions := []string{
+		"cron(0 * * * ? *)",
+		"cron(0 8,20 * * ? *)",
+		"cron(0/30 16 * * ? *)",
+		"rate(1 minute)",
+		"rate(5 minutes)",
+		"rate(1439 minutes)",
+		"rate(1 hour)",
+		"rate(4 hours)",
+		"rate(23 hours)",
+		"rate(0 days)",
+		"daily",
+	}
+	for _, v := range invalidExpressions {
+		_, errors := ValidRotationScheduleExpression(v, "schedule_expression")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid rotation schedule expression", v)
+		}
+	}
+
+	_, errors := ValidRotationScheduleExpression("cron(0 * * * ? *)", "schedule_expression")
+	if len(errors) != 1 || !strings.Contains(errors[0].Error(), "runs more than once a day") {
+		t.Fatalf("expected sub-daily error, got %q", errors)
+	}
+
+	_, errors = ValidRotationScheduleExpression("rate(1 hour)", "schedule_expression")
+	if len(errors) != 1 || !strings.Contains(errors[0].Error(), "runs more than once a day: rotation schedule rates must be at least 24 hours") {
+		t.Fatalf("expected sub-daily rate error, got %q", errors)
+	}
+}
+
+func TestValidAWSCronExpression(t *testing.T) {
+	t.Parallel()
+
+	validExpressions := []string{
+		"cron(0 10 * * ? *)",
+		"cron(15 12 * * ? *)",
+		"cron(0 18 ? * MON-FRI *)",
+		"cron(0 8 1 * ? *)",
+		"cron(0/15 * * * ? *)",
+		"cron(0/10 * ? * MON-FRI *)",
+		"cron(0/5 8-17 ? * MON-FRI *)",
+		"cron(0 9 ? * 2#1 *)",
+		"cron(0 0 L * ? *)",
+		"cron(0 0 15W * ? *)",
+		"cron(0 0 ? * 6L *)",
+		"cron(59 23 31 DEC ? 2199)",
+		"cron(0 0 1 jan,jul ? 1970-2000)",
+	}
+	for _, v := range validExpressions {
+		_, errors := ValidAWSCronExpression(v, "schedule_expression")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid AWS cron expression: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"cron(60 10 * * ? *)", "minutes field value (60) must be between 0 and 59"},
+		{"cron(0 24 * * ? *)", "hours field value (24) must be between 0 and 23"},
+		{"cron(0 10 0 * ? *)", "day-of-month field value (0) must be between 1 and 31"},
+		{"cron(0 10 32 * ? *)", "day-of-month field value (32) must be between 1 and 31"},
+		{"cron(0 10 * 13 ? *)", "month field value (13) must be between 1 and 12"},
+		{"cron(0 10 * JANUARY ? *)", `month field value "JANUARY" is not a number`},
+		{"cron(0 10 ? * 8 *)", "day-of-week field value (8) must be between 1 and 7"},
+		{"cron(0 10 ? * 2#6 *)", `day-of-week field occurrence "6" must be between 1 and 5`},
+		{"cron(0 10 * * ? 1969)", "year field value (1969) must be between 1970 and 2199"},
+		{"cron(0 10 * * ? 2200)", "year field value (2200) must be between 1970 and 2199"},
+		{"cron(0/0 10 * * ? *)", `minutes field increment "0" must be a positive number`},
+		{"cron(? 10 * * ? *)", `minutes field must not be "?"`},
+		{"cron(0 10 * * * *)", `exactly one of the day-of-month (*) and day-of-week (*) fields must be "?"`},
+		{"cron(0 10 ? * ? *)", `exactly one of the day-of-month (?) and day-of-week (?) fields must be "?"`},
+		{"cron(0 10 1 * MON *)", `exactly one of the day-of-month (1) and day-of-week (MON) fields must be "?"`},
+		{"cron(0 10 * * ?)", "cron expression must have 6 fields"},
+		{"rate(1 day)", "must be a cron(fields) expression"},
+	}
+	for _, tc := range cases {
+		_, errors := ValidAWSCronExpression(tc.Value, "schedule_expression")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidGlueCatalogTableNameAndAthenaDatabaseName(t *testing.T) {
+	t.Parallel()
+
+	for _, f := range []schema.SchemaValidateFunc{ValidGlueCatalogTableName, ValidAthenaDatabaseName} {
+		validNames := []string{
+			"a",
+			"my_table",
+			"table_2023",
+			"_private",
+			strings.Repeat("a", 255),
+		}
+		for _, v := range validNames {
+			_, errors := f(v, "name")
+			if len(errors) != 0 {
+				t.Fatalf("%q should be a valid name: %q", v, errors)
+			}
+		}
+
+		cases := []struct {
+			Value             string
+			ExpectedErrSubstr string
+		}{
+			{"", "cannot be shorter than 1 character"},
+			{strings.Repeat("a", 256), "cannot be longer than 255 characters"},
+			{"MyTable", "must not contain uppercase characters"},
+			{"my-table", "must not contain hyphens"},
+			{"my table", "can only contain lowercase alphanumeric characters and underscores"},
+			{"my.table", "can only contain lowercase alphanumeric characters and underscores"},
+		}
+		for _, tc := range cases {
+			_, errors := f(tc.Value, "name")
+			if len(errors) != 1 {
+				t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+			}
+			if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+				t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+			}
+		}
+	}
+}
+
+func TestValidRoute53RecordType(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range Route53RecordTypes {
+		_, errors := ValidRoute53RecordType(v, "type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid record type: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"cname", `got cname; did you mean "CNAME"?`},
+		{"CNAM", `got CNAM; did you mean "CNAME"?`},
+		{"ALIAS", `got ALIAS`},
+		{"", `got `},
+	}
+	for _, tc := range cases {
+		_, errors := ValidRoute53RecordType(tc.Value, "type")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+
+	_, errors := ValidRoute53RecordType("ALIAS", "type")
+	if strings.Contains(errors[0].Error(), "did you mean") {
+		t.Fatalf("expected no suggestion for ALIAS, got %q", errors[0])
+	}
+}
+
+func TestValidRoute53TXTRecordValue(t *testing.T) {
+	t.Parallel()
+
+	validValues := []string{
+		"v=spf1 include:_spf.example.com ~all",
+		`"v=spf1 include:_spf.example.com ~all"`,
+		`"first" "second"`,
+		`"escaped \" quote"`,
+		strings.Repeat("a", 255),
+		strings.Repeat("a", 255) + `""` + strings.Repeat("b", 255),
+		`"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("b", 255) + `"`,
+	}
+	for _, v := range validValues {
+		_, errors := ValidRoute53TXTRecordValue(v, "records")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid TXT record value: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{strings.Repeat("a", 256), "character string 0 is 256 characters long"},
+		{strings.Repeat("a", 10) + `""` + strings.Repeat("b", 256), "character string 1 is 256 characters long"},
+		{`"` + strings.Repeat("a", 256) + `"`, "character string 0 is 256 characters long"},
+		{`"unterminated`, "unterminated quoted character string 0"},
+		{`"first" second`, "unexpected characters after quoted character string 1"},
+	}
+	for _, tc := range cases {
+		_, errors := ValidRoute53TXTRecordValue(tc.Value, "records")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidEFSThroughputMode(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"bursting", "elastic", "provisioned"} {
+		_, errors := ValidEFSThroughputMode(v, "throughput_mode")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid throughput mode: %q", v, errors)
+		}
+	}
+
+	for _, v := range []string{"", "Bursting", "burst", "unlimited"} {
+		_, errors := ValidEFSThroughputMode(v, "throughput_mode")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid throughput mode", v)
+		}
+	}
+}
+
+func TestValidFSxDeploymentType(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range FSxDeploymentTypes {
+		_, errors := ValidFSxDeploymentType(v, "deployment_type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid deployment type: %q", v, errors)
+		}
+	}
+
+	for _, v := range []string{"", "scratch_1", "PERSISTENT_3", "MULTI_AZ"} {
+		_, errors := ValidFSxDeploymentType(v, "deployment_type")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid deployment type", v)
+		}
+	}
+}
+
+func TestValidSecurityGroupID(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"sg-12345678", "sg-0123456789abcdef0"} {
+		_, errors := ValidSecurityGroupID(v, "security_group_id")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid security group ID: %q", v, errors)
+		}
+	}
+
+	for _, v := range []string{"", "sg-", "sg-1234567", "sg-123456789", "sg-ABCDEF12", "12345678", "sgr-12345678"} {
+		_, errors := ValidSecurityGroupID(v, "security_group_id")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid security group ID", v)
+		}
+	}
+}
+
+func TestSecurityGroupIDsWithinLimit(t *testing.T) {
+	t.Parallel()
+
+	f := SecurityGroupIDsWithinLimit(5)
+	ids := func(n int) []interface{} {
+		l := make([]interface{}, n)
+		for i := range l {
+			l[i] = fmt.Sprintf("sg-%08d", i)
+		}
+		return l
+	}
+
+	runDiagTestCases(t, map[string]diagTestCase{
+		"empty": {
+			val: []interface{}{},
+			f:   f,
+		},
+		"at limit": {
+			val: ids(5),
+			f:   f,
+		},
+		"set at limit": {
+			val: schema.NewSet(schema.HashString, ids(5)),
+			f:   f,
+		},
+		"over limit": {
+			val:             ids(6),
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value$`),
+			expectedDetail:  regexache.MustCompile(`^Expected at most 5 security group IDs, got 6$`),
+		},
+		"malformed ID": {
+			val:             []interface{}{"sg-12345678", "sg-xyz"},
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value$`),
+			expectedDetail:  regexache.MustCompile(`"sg-xyz" is not a valid security group ID`),
+		},
+		"wrong type": {
+			val:             "sg-12345678",
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value type$`),
+			expectedDetail:  regexache.MustCompile(`^Expected type to be list$`),
+		},
+	})
+
+	diags := f([]interface{}{"sg-12345678", "sg-xyz"}, cty.GetAttrPath("vpc_security_group_ids"))
+	if want := cty.GetAttrPath("vpc_security_group_ids").IndexInt(1); len(diags) != 1 || !diags[0].AttributePath.Equals(want) {
+		t.Fatalf("expected 1 diagnostic with path %#v, got %#v", want, diags)
+	}
+}
+
+func TestListLenBetween(t *testing.T) {
+	t.Parallel()
+
+	f := ListLenBetween(1, 3)
+	values := func(n int) []interface{} {
+		l := make([]interface{}, n)
+		for i := range l {
+			l[i] = fmt.Sprintf("value-%d", i)
+		}
+		return l
+	}
+
+	runDiagTestCases(t, map[string]diagTestCase{
+		"at min": {
+			val: values(1),
+			f:   f,
+		},
+		"at max": {
+			val: values(3),
+			f:   f,
+		},
+		"set at max": {
+			val: schema.NewSet(schema.HashString, values(3)),
+			f:   f,
+		},
+		"below min": {
+			val:             values(0),
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value$`),
+			expectedDetail:  regexache.MustCompile(`^Expected between 1 and 3 elements, got 0$`),
+		},
+		"above max": {
+			val:             values(4),
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value$`),
+			expectedDetail:  regexache.MustCompile(`^Expected between 1 and 3 elements, got 4$`),
+		},
+		"wrong type": {
+			val:             "value",
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value type$`),
+		},
+	})
+}
+
+func TestIntListSumAtMost(t *testing.T) {
+	t.Parallel()
+
+	f := IntListSumAtMost(100)
+
+	runDiagTestCases(t, map[string]diagTestCase{
+		"empty": {
+			val: []interface{}{},
+			f:   f,
+		},
+		"below cap": {
+			val: []interface{}{20, 30},
+			f:   f,
+		},
+		"at cap": {
+			val: []interface{}{50, 25, 25},
+			f:   f,
+		},
+		"set at cap": {
+			val: schema.NewSet(schema.HashInt, []interface{}{60, 40}),
+			f:   f,
+		},
+		"above cap": {
+			val:             []interface{}{50, 25, 26},
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value$`),
+			expectedDetail:  regexache.MustCompile(`^Expected elements to sum to at most 100, got 101$`),
+		},
+		"wrong element type": {
+			val:             []interface{}{50, "25"},
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value type$`),
+			expectedDetail:  regexache.MustCompile(`^Expected type to be integer$`),
+		},
+		"wrong type": {
+			val:             100,
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value type$`),
+			expectedDetail:  regexache.MustCompile(`^Expected type to be list$`),
+		},
+	})
+}
+
+func TestPortRangesNoOverlap(t *testing.T) {
+	t.Parallel()
+
+	f := PortRangesNoOverlap
+
+	runDiagTestCases(t, map[string]diagTestCase{
+		"empty": {
+			val: []interface{}{},
+			f:   f,
+		},
+		"disjoint": {
+			val: []interface{}{"22", "80", "443", "1024-2048", "2049-4096"},
+			f:   f,
+		},
+		"set disjoint": {
+			val: schema.NewSet(schema.HashString, []interface{}{"80-89", "90-99"}),
+			f:   f,
+		},
+		"overlapping ranges": {
+			val:             []interface{}{"22", "1024-2048", "2000-3000"},
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value$`),
+			expectedDetail:  regexache.MustCompile(`^Port "2000-3000" at index 2 overlaps port "1024-2048" at index 1$`),
+		},
+		"port within range": {
+			val:             []interface{}{"8000-9000", "8080"},
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value$`),
+			expectedDetail:  regexache.MustCompile(`^Port "8080" at index 1 overlaps port "8000-9000" at index 0$`),
+		},
+		"duplicate ports": {
+			val:             []interface{}{"80", "443", "80"},
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value$`),
+			expectedDetail:  regexache.MustCompile(`^Duplicate port "80" at indices 0 and 2$`),
+		},
+		"invalid port": {
+			val:             []interface{}{"80", "65536"},
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value$`),
+			expectedDetail:  regexache.MustCompile(`"65536" is not a valid port`),
+		},
+		"reversed range": {
+			val:             []interface{}{"2048-1024"},
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value$`),
+			expectedDetail:  regexache.MustCompile(`"2048-1024" is not a valid port range`),
+		},
+		"wrong type": {
+			val:             "80",
+			f:               f,
+			expectedSummary: regexache.MustCompile(`^Invalid value type$`),
+		},
+	})
+}
+
+func TestValidLambdaRuntime(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"nodejs18.x", "python3.11", "java17", "provided.al2"} {
+		ws, errors := ValidLambdaRuntime(v, "runtime")
+		if len(ws) != 0 || len(errors) != 0 {
+			t.Fatalf("%q should be a valid Lambda runtime: %q, %q", v, ws, errors)
+		}
+	}
+
+	for _, v := range []string{"python2.7", "nodejs12.x", "go1.x"} {
+		ws, errors := ValidLambdaRuntime(v, "runtime")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid (deprecated) Lambda runtime: %q", v, errors)
+		}
+		if len(ws) != 1 || !strings.Contains(ws[0], "deprecated Lambda runtime") {
+			t.Fatalf("%q should produce a deprecation warning, got %q", v, ws)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"python3.99", `is not a known Lambda runtime; did you mean`},
+		{"nodejs18", `is not a known Lambda runtime; did you mean "nodejs18.x"?`},
+		{"cobol", `is not a known Lambda runtime, expected one of`},
+	}
+	for _, tc := range cases {
+		_, errors := ValidLambdaRuntime(tc.Value, "runtime")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidLambdaMemorySize(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []int{128, 129, 1024, 1536, 10240} {
+		_, errors := ValidLambdaMemorySize(v, "memory_size")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid Lambda memory size: %q", v, errors)
+		}
+	}
+
+	for _, v := range []interface{}{0, 64, 127, 10241, -128, "128", 128.0} {
+		_, errors := ValidLambdaMemorySize(v, "memory_size")
+		if len(errors) == 0 {
+			t.Fatalf("%v should be an invalid Lambda memory size", v)
+		}
+	}
+
+	_, errors := ValidLambdaMemorySize(64, "memory_size")
+	if !strings.Contains(errors[0].Error(), "must be between 128 and 10240 MB") {
+		t.Fatalf("expected range error, got %q", errors[0])
+	}
+}
+
+func TestValidCloudWatchNamespace(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"MyApp",
+		"Custom/MyApp",
+		"my-app.metrics_v1#prod: web",
+		strings.Repeat("a", 255),
+	}
+	for _, v := range validNames {
+		_, errors := ValidCloudWatchNamespace(v, "namespace")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CloudWatch namespace: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		":MyApp",
+		strings.Repeat("a", 256),
+		"My$App",
+		"MyApp\n",
+	}
+	for _, v := range invalidNames {
+		_, errors := ValidCloudWatchNamespace(v, "namespace")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CloudWatch namespace", v)
+		}
+	}
+}
+
+func TestValidCloudWatchMetricName(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"CPUUtilization",
+		"Requests (5xx) / min",
+		"métrique",
+		strings.Repeat("a", 255),
+	}
+	for _, v := range validNames {
+		_, errors := ValidCloudWatchMetricName(v, "metric_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CloudWatch metric name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		strings.Repeat("a", 256),
+		"Metric\x00Name",
+	}
+	for _, v := range invalidNames {
+		_, errors := ValidCloudWatchMetricName(v, "metric_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CloudWatch metric name", v)
+		}
+	}
+}
+
+func TestValidJSONNoControlChars(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{Value: `{"a":"b","c":[1,"d\te\nf\r"],"g":{"h":null}}`},
+		{Value: `"caf\u00e9"`},
+		{Value: `{"a":{"b":["ok","bad\u0001"]}}`, ExpectedErrSubstr: `contains a disallowed control character at $["a"]["b"][1]`},
+		{Value: `{"bad\u001f":"ok"}`, ExpectedErrSubstr: `contains a disallowed control character at $["bad\x1f"]`},
+		{Value: `["\u007f"]`, ExpectedErrSubstr: `contains a disallowed control character at $[0]`},
+		{Value: "{\"a\":\"raw\x01byte\"}", ExpectedErrSubstr: `contains an invalid JSON`},
+		{Value: "{\"a\":\"\xff\"}", ExpectedErrSubstr: `contains invalid UTF-8`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidJSONNoControlChars(tc.Value, "json")
+		if tc.ExpectedErrSubstr == "" {
+			if len(errors) != 0 {
+				t.Fatalf("%q: expected no error, got %q", tc.Value, errors)
+			}
+			continue
+		}
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidDBParameterGroupFamily(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"mysql8.0", "postgres15", "aurora-mysql8.0", "sqlserver-ee-15.0", "mariadb10.11"} {
+		ws, errors := ValidDBParameterGroupFamily(v, "family")
+		if len(ws) != 0 || len(errors) != 0 {
+			t.Fatalf("%q should be a known DB parameter group family: %q, %q", v, ws, errors)
+		}
+	}
+
+	for _, v := range []string{"mysql9.0", "postgres99", "docdb5.0"} {
+		ws, errors := ValidDBParameterGroupFamily(v, "family")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DB parameter group family: %q", v, errors)
+		}
+		if len(ws) != 1 || !strings.Contains(ws[0], "is not a known DB parameter group family") {
+			t.Fatalf("%q should produce an unknown family warning, got %q", v, ws)
+		}
+	}
+
+	for _, v := range []string{"", "mysql", "8.0", "MySQL8.0", "mysql--8.0", "mysql8.0.", "mysql 8.0"} {
+		_, errors := ValidDBParameterGroupFamily(v, "family")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DB parameter group family", v)
+		}
+	}
+}
+
+func TestValidCacheNodeType(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"cache.t3.micro", "cache.r6g.large", "cache.m5.24xlarge", "cache.r6gd.xlarge"} {
+		ws, errors := ValidCacheNodeType(v, "node_type")
+		if len(ws) != 0 || len(errors) != 0 {
+			t.Fatalf("%q should be a known cache node type: %q, %q", v, ws, errors)
+		}
+	}
+
+	for _, v := range []string{"cache.x9.large", "cache.m99.xlarge"} {
+		ws, errors := ValidCacheNodeType(v, "node_type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid cache node type: %q", v, errors)
+		}
+		if len(ws) != 1 || !strings.Contains(ws[0], "has an unknown node type family") {
+			t.Fatalf("%q should produce an unknown family warning, got %q", v, ws)
+		}
+	}
+
+	for _, v := range []string{"t3.micro", "db.t3.micro", "cache-t3-micro"} {
+		_, errors := ValidCacheNodeType(v, "node_type")
+		if len(errors) != 1 || !strings.Contains(errors[0].Error(), `must begin with "cache."`) {
+			t.Fatalf("%q should be missing the cache. prefix, got %q", v, errors)
+		}
+	}
+
+	for _, v := range []string{"cache.", "cache.t3", "cache.t3.micro.extra", "cache.T3.micro", "cache..micro"} {
+		_, errors := ValidCacheNodeType(v, "node_type")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid cache node type", v)
+		}
+	}
+}
+
+func TestValidRegexp(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"", "^abc$", `^[a-z0-9-]+\.example\.com$`, "(foo|bar)+", `\d{3}-\d{4}`} {
+		_, errors := ValidRegexp(v, "regex_string")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid regular expression: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value       string
+		ExpectedErr string
+	}{
+		{"(abc", "\"regex_string\" ((abc) is not a valid regular expression: missing closing ): `(abc`"},
+		{"abc)", "\"regex_string\" (abc)) is not a valid regular expression: unexpected ): `abc)`"},
+		{`a\qb`, "\"regex_string\" (a\\qb) is not a valid regular expression: invalid escape sequence: `\\q`"},
+		{"[a-", "\"regex_string\" ([a-) is not a valid regular expression: missing closing ]: `[a-`"},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidRegexp(tc.Value, "regex_string")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if got := errors[0].Error(); got != tc.ExpectedErr {
+			t.Fatalf("%q: expected error %q, got %q", tc.Value, tc.ExpectedErr, got)
+		}
+	}
+}
+
+func TestValidCountryCode(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"US", "us", "Gb", "AX", "ZW"} {
+		_, errors := ValidCountryCode(v, "country_code")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid country code: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"ZZ", `"country_code" (ZZ) is not an ISO 3166-1 alpha-2 country code; did you mean "AZ"?`},
+		{"", `"country_code" () is not an ISO 3166-1 alpha-2 country code`},
+		{"USA", `is not an ISO 3166-1 alpha-2 country code; did you mean "US"?`},
+		{"fra", `is not an ISO 3166-1 alpha-2 country code; did you mean "FR"?`},
+		{"uk", `is not an ISO 3166-1 alpha-2 country code; did you mean "GB"?`},
+		{"EL", `did you mean "GR"?`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidCountryCode(tc.Value, "country_code")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidXRaySamplingRate(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []float64{0.0, 0.05, 0.5, 1.0} {
+		_, errors := ValidXRaySamplingRate(v, "fixed_rate")
+		if len(errors) != 0 {
+			t.Fatalf("%g should be a valid X-Ray sampling rate: %q", v, errors)
+		}
+	}
+
+	for _, v := range []interface{}{-0.01, 1.01, 5.0, 1, "0.5"} {
+		_, errors := ValidXRaySamplingRate(v, "fixed_rate")
+		if len(errors) == 0 {
+			t.Fatalf("%v should be an invalid X-Ray sampling rate", v)
+		}
+	}
+
+	_, errors := ValidXRaySamplingRate(1.5, "fixed_rate")
+	if !strings.Contains(errors[0].Error(), `"fixed_rate" (1.5) must be between 0.0 and 1.0`) {
+		t.Fatalf("expected range error, got %q", errors[0])
+	}
+}
+
+func TestValidXRaySamplingPriority(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []int{1, 2, 1000, 9998, 9999} {
+		_, errors := ValidXRaySamplingPriority(v, "priority")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid X-Ray sampling priority: %q", v, errors)
+		}
+	}
+
+	for _, v := range []interface{}{0, -1, 10000, "1", 1.0} {
+		_, errors := ValidXRaySamplingPriority(v, "priority")
+		if len(errors) == 0 {
+			t.Fatalf("%v should be an invalid X-Ray sampling priority", v)
+		}
+	}
+
+	_, errors := ValidXRaySamplingPriority(0, "priority")
+	if !strings.Contains(errors[0].Error(), `"priority" (0) must be between 1 and 9999`) {
+		t.Fatalf("expected range error, got %q", errors[0])
+	}
+}
+
+func TestValidKMSKeyPolicyRootAccess(t *testing.T) {
+	t.Parallel()
+
+	validPolicies := []string{
+		"",
+		`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Enable IAM User Permissions",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::123456789012:root"},
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}`, // lintignore:AWSAT005
+		`{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": {"AWS": ["arn:aws:iam::123456789012:role/admin", "123456789012"]},
+    "Action": ["kms:Describe*", "kms:PutKeyPolicy"],
+    "Resource": "*"
+  }
+}`, // lintignore:AWSAT005
+	}
+
+	for _, v := range validPolicies {
+		ws, errors := ValidKMSKeyPolicyRootAccess(v, "policy")
+		if len(ws) != 0 || len(errors) != 0 {
+			t.Fatalf("%q should allow root access: %q, %q", v, ws, errors)
+		}
+	}
+
+	noRootAccessPolicies := []string{
+		`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::123456789012:role/admin"},
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}`, // lintignore:AWSAT005
+		`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::123456789012:root"},
+      "Action": ["kms:Encrypt", "kms:Decrypt"],
+      "Resource": "*"
+    }
+  ]
+}`, // lintignore:AWSAT005
+		`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Deny",
+      "Principal": {"AWS": "arn:aws:iam::123456789012:root"},
+      "Action": "kms:*",
+      "Resource": "*"
+    }
+  ]
+}`, // lintignore:AWSAT005
+	}
+
+	for _, v := range noRootAccessPolicies {
+		ws, errors := ValidKMSKeyPolicyRootAccess(v, "policy")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid policy: %q", v, errors)
+		}
+		if len(ws) != 1 || ws[0] != `"policy": no statement allows the account root principal (arn:<partition>:iam::<account>:root) kms:* or kms:PutKeyPolicy access; the KMS key may become unmanageable` {
+			t.Fatalf("%q should produce a root access warning, got %q", v, ws)
+		}
+	}
+
+	_, errors := ValidKMSKeyPolicyRootAccess(`{"Statement":`, "policy")
+	if len(errors) != 1 {
+		t.Fatalf("expected invalid JSON policy error, got %q", errors)
+	}
+}
+
+func TestValidInstanceType(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"m5.large", "t3.micro", "c6gn.16xlarge", "m7i-flex.xlarge", "mac2.metal", "r6id.metal"} {
+		ws, errors := ValidInstanceType(v, "instance_type")
+		if len(ws) != 0 || len(errors) != 0 {
+			t.Fatalf("%q should be a known instance type: %q, %q", v, ws, errors)
+		}
+	}
+
+	for _, v := range []string{"q9.large", "m99.xlarge"} {
+		ws, errors := ValidInstanceType(v, "instance_type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid instance type: %q", v, errors)
+		}
+		if len(ws) != 1 || !strings.Contains(ws[0], "has an unknown instance type family") {
+			t.Fatalf("%q should produce an unknown family warning, got %q", v, ws)
+		}
+	}
+
+	for _, v := range []string{"", "m5large", "m5.", ".large", "m.large", "M5.large", "m5.large.extra", "cache.m5.large"} {
+		_, errors := ValidInstanceType(v, "instance_type")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid instance type", v)
+		}
+	}
+}
+
+func TestValidBatchName(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"a",
+		"my-job_definition",
+		"MyComputeEnvironment01",
+		strings.Repeat("a", 128),
+	}
+	for _, v := range validNames {
+		_, errors := ValidBatchName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Batch name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		strings.Repeat("a", 129),
+		"my.job",
+		"my job",
+		"my/job",
+		"my:job",
+	}
+	for _, v := range invalidNames {
+		_, errors := ValidBatchName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Batch name", v)
+		}
+	}
+
+	_, errors := ValidBatchName("my.job", "name")
+	if !strings.Contains(errors[0].Error(), "can only contain uppercase and lowercase letters, numbers, hyphens and underscores") {
+		t.Fatalf("expected charset error, got %q", errors[0])
+	}
+}
+
+func TestValidViewerProtocolPolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"allow-all", "https-only", "redirect-to-https"} {
+		_, errors := ValidViewerProtocolPolicy(v, "viewer_protocol_policy")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid viewer protocol policy: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"redirect-to-http", `got redirect-to-http; did you mean "redirect-to-https"?`},
+		{"https_only", `got https_only; did you mean "https-only"?`},
+		{"HTTPS-ONLY", `got HTTPS-ONLY; did you mean "https-only"?`},
+		{"http", `got http`},
+	}
+	for _, tc := range cases {
+		_, errors := ValidViewerProtocolPolicy(tc.Value, "viewer_protocol_policy")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidGoTemplate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value             string
+		AllowedFuncs      []string
+		ExpectedErrSubstr string
+	}{
+		{"", nil, ""},
+		{"Hello, {{ .Name }}!", nil, ""},
+		{`{{ range .Items }}{{ printf "%s" . }}{{ end }}`, nil, ""},
+		{"{{ upper .Name }}", nil, ""},
+		{"{{ upper .Name }}", []string{"upper", "lower"}, ""},
+		{"{{ len .Items }}", []string{"upper"}, ""},
+		{"{{ .Name ", nil, "unclosed action"},
+		{"{{ if .Name }}", nil, "unexpected EOF"},
+		{"{{ end }}", nil, "unexpected {{end}}"},
+		{"{{ title .Name }}", []string{"upper", "lower"}, `function "title" not defined`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidGoTemplate(tc.AllowedFuncs...)(tc.Value, "template")
+		if tc.ExpectedErrSubstr == "" {
+			if len(errors) != 0 {
+				t.Fatalf("%q (%q): expected no error, got %q", tc.Value, tc.AllowedFuncs, errors)
+			}
+			continue
+		}
+		if len(errors) != 1 {
+			t.Fatalf("%q (%q): expected 1 error, got %d: %q", tc.Value, tc.AllowedFuncs, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q (%q): expected error %q to include %q", tc.Value, tc.AllowedFuncs, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidSageMakerName(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"a",
+		"my-model",
+		"My--Model-01",
+		strings.Repeat("a", 63),
+	}
+	for _, v := range validNames {
+		_, errors := ValidSageMakerName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid SageMaker name: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"", "cannot be shorter than 1 character"},
+		{strings.Repeat("a", 64), "cannot be longer than 63 characters"},
+		{"-my-model", "must begin with an alphanumeric character"},
+		{"my-model-", "must not end with a hyphen"},
+		{"my_model", "can only contain alphanumeric characters and hyphens"},
+		{"my.model", "can only contain alphanumeric characters and hyphens"},
+	}
+	for _, tc := range cases {
+		_, errors := ValidSageMakerName(tc.Value, "name")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidSageMakerNamePrefix(t *testing.T) {
+	t.Parallel()
+
+	validPrefixes := []string{
+		"a",
+		"my-model",
+		"my-model-",
+		strings.Repeat("a", 37),
+	}
+	for _, v := range validPrefixes {
+		_, errors := ValidSageMakerNamePrefix(v, "name_prefix")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid SageMaker name prefix: %q", v, errors)
+		}
+	}
+
+	invalidPrefixes := []string{
+		"",
+		strings.Repeat("a", 38),
+		"-my-model",
+		"my_model-",
+	}
+	for _, v := range invalidPrefixes {
+		_, errors := ValidSageMakerNamePrefix(v, "name_prefix")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid SageMaker name prefix", v)
+		}
+	}
+}
+
+func TestValidS3BucketName(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"abc",
+		"my-bucket",
+		"my.bucket.example.com",
+		"123bucket",
+		strings.Repeat("a", 63),
+	}
+	for _, v := range validNames {
+		_, errors := ValidS3BucketName(v, "bucket")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid S3 bucket name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"ab",
+		strings.Repeat("a", 64),
+		"My-Bucket",
+		"my_bucket",
+		"-my-bucket",
+		"my-bucket-",
+		".my-bucket",
+		"my-bucket.",
+		"my..bucket",
+		"192.168.5.4",
+		"192.168.05.4",
+	}
+	for _, v := range invalidNames {
+		_, errors := ValidS3BucketName(v, "bucket")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid S3 bucket name", v)
+		}
+	}
+}
+
+func TestValidS3PlainBucketName(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"my-bucket", "my.bucket.example.com"} {
+		_, errors := ValidS3PlainBucketName(v, "bucket")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid plain S3 bucket name: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"my-access-point-hrzrlukc5m36ft7okagglf3gmwluquse1b-s3alias", "is an S3 access point alias"},
+		{"my-access-point-o01ac5d28a6a232904e8xz5w8ijx1qzlbp3i3kuso8--op-s3", "is an S3 on Outposts access point alias"},
+		{"arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/my-access-point", "is an ARN"}, // lintignore:AWSAT003,AWSAT005
+		{"arn:aws:s3:::my-bucket", "is an ARN"}, // lintignore:AWSAT005
+		{"My_Bucket", "can only contain lowercase alphanumeric characters"},
+	}
+	for _, tc := range cases {
+		_, errors := ValidS3PlainBucketName(tc.Value, "bucket")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidS3WebsiteBucketName(t *testing.T) {
+	t.Parallel()
+
+	ws, errors := ValidS3WebsiteBucketName("my-website-bucket", "bucket")
+	if len(errors) != 0 || len(ws) != 0 {
+		t.Fatalf("expected no warnings or errors for a dotless bucket name, got %q %q", ws, errors)
+	}
+
+	ws, errors = ValidS3WebsiteBucketName("www.example.com", "bucket")
+	if len(errors) != 0 {
+		t.Fatalf("expected no errors for a dotted bucket name, got %q", errors)
+	}
+	if len(ws) != 1 || !strings.Contains(ws[0], `"bucket" (www.example.com) contains periods`) {
+		t.Fatalf("expected a warning for a dotted bucket name, got %q", ws)
+	}
+
+	ws, errors = ValidS3WebsiteBucketName("My.Bucket", "bucket")
+	if len(errors) == 0 {
+		t.Fatal("expected errors for an invalid bucket name")
+	}
+	if len(ws) != 0 {
+		t.Fatalf("expected no warnings for an invalid bucket name, got %q", ws)
+	}
+}
+
+func TestValidFindingPublishingFrequency(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"FIFTEEN_MINUTES", "ONE_HOUR", "SIX_HOURS"} {
+		_, errors := ValidFindingPublishingFrequency(v, "finding_publishing_frequency")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid finding publishing frequency: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"FIFTEEN_MINUTE", `got FIFTEEN_MINUTE; did you mean "FIFTEEN_MINUTES"?`},
+		{"one_hour", `got one_hour; did you mean "ONE_HOUR"?`},
+		{"SIX-HOURS", `got SIX-HOURS; did you mean "SIX_HOURS"?`},
+		{"DAILY", `got DAILY`},
+	}
+	for _, tc := range cases {
+		_, errors := ValidFindingPublishingFrequency(tc.Value, "finding_publishing_frequency")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidDashboardBody(t *testing.T) {
+	t.Parallel()
+
+	validBodies := []string{
+		`{"widgets": []}`,
+		`{
+  "widgets": [
+    {"type": "metric", "x": 0, "y": 0, "width": 12, "height": 6, "properties": {}},
+    {"type": "text", "x": 12, "y": 0, "width": 12, "height": 6, "properties": {"markdown": "Hello"}},
+    {"type": "alarm"}
+  ]
+}`,
+	}
+	for _, v := range validBodies {
+		_, errors := ValidDashboardBody(v, "dashboard_body")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid dashboard body: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{`{}`, "widgets array is required"},
+		{`[]`, "contains an invalid dashboard body"},
+		{`{"widgets": [`, "contains an invalid JSON"},
+		{`{"widgets": [{"x": 0}]}`, "widget 0: type is required"},
+		{`{"widgets": [{"type": "text"}, {"type": "metric", "x": 24}]}`, "widget 1: x (24) must be between 0 and 23"},
+		{`{"widgets": [{"type": "metric", "x": 18, "width": 12}]}`, "widget 0: width (12) at x (18) must fit within the 24-column grid"},
+		{`{"widgets": [{"type": "metric", "width": 0}]}`, "widget 0: width (0) at x (0) must fit within the 24-column grid"},
+		{fmt.Sprintf(`{"widgets": [{"type": "text", "properties": {"markdown": "%s"}}]}`, strings.Repeat("x", 100*1024)), "bytes long, the maximum is 102400 bytes"},
+	}
+	for _, tc := range cases {
+		_, errors := ValidDashboardBody(tc.Value, "dashboard_body")
+		if len(errors) != 1 {
+			t.Fatalf("%.80q: expected 1 error, got %d: %.200q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%.80q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidVaultName(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"a",
+		"my-vault",
+		"My_Vault_01",
+		strings.Repeat("a", 255),
+	}
+	for _, v := range validNames {
+		_, errors := ValidVaultName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid vault name: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"", "cannot be shorter than 1 character"},
+		{strings.Repeat("a", 256), "cannot be longer than 255 characters"},
+		{"my vault", "must not contain spaces; use hyphens or underscores instead"},
+		{"my.vault", "can only contain letters, numbers, hyphens and underscores"},
+		{"my/vault", "can only contain letters, numbers, hyphens and underscores"},
+	}
+	for _, tc := range cases {
+		_, errors := ValidVaultName(tc.Value, "name")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidTargetGroupWeight(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []int{0, 1, 100, 998, 999} {
+		_, errors := ValidTargetGroupWeight(v, "weight")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid target group weight: %q", v, errors)
+		}
+	}
+
+	for _, v := range []interface{}{-1, 1000, 10000, "1", 1.0} {
+		_, errors := ValidTargetGroupWeight(v, "weight")
+		if len(errors) == 0 {
+			t.Fatalf("%v should be an invalid target group weight", v)
+		}
+	}
+
+	_, errors := ValidTargetGroupWeight(1000, "weight")
+	if !strings.Contains(errors[0].Error(), `"weight" (1000) must be between 0 and 999`) {
+		t.Fatalf("expected range error, got %q", errors[0])
+	}
+}
+
+func TestValidRedshiftClusterIdentifier(t *testing.T) {
+	t.Parallel()
+
+	validIdentifiers := []string{
+		"a",
+		"my-cluster",
+		"cluster01",
+		strings.Repeat("a", 63),
+	}
+	for _, v := range validIdentifiers {
+		_, errors := ValidRedshiftClusterIdentifier(v, "cluster_identifier")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Redshift cluster identifier: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"", "cannot be shorter than 1 character"},
+		{strings.Repeat("a", 64), "cannot be longer than 63 characters"},
+		{"1cluster", "first character of \"cluster_identifier\" must be a letter"},
+		{"my_Cluster", "only lowercase alphanumeric characters and hyphens allowed"},
+		{"my--cluster", "cannot contain two consecutive hyphens"},
+		{"my-cluster-", "cannot end with a hyphen"},
+	}
+	for _, tc := range cases {
+		_, errors := ValidRedshiftClusterIdentifier(tc.Value, "cluster_identifier")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidRedshiftNodeType(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range RedshiftNodeTypes {
+		_, errors := ValidRedshiftNodeType(v, "node_type")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Redshift node type: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"ra3.4xlage", `got ra3.4xlage; did you mean "ra3.4xlarge"?`},
+		{"m5.large", `got m5.large`},
+	}
+	for _, tc := range cases {
+		_, errors := ValidRedshiftNodeType(tc.Value, "node_type")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidIoTThingName(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"a",
+		"my-thing",
+		"my_thing:01",
+		strings.Repeat("a", 128),
+	}
+	for _, v := range validNames {
+		_, errors := ValidIoTThingName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IoT thing name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		strings.Repeat("a", 129),
+		"my thing",
+		"my/thing",
+		"my.thing",
+	}
+	for _, v := range invalidNames {
+		_, errors := ValidIoTThingName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IoT thing name", v)
+		}
+	}
+}
+
+func TestValidIoTTopic(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value             string
+		Subscribe         bool
+		ExpectedErrSubstr string
+	}{
+		{"sensors/temperature", false, ""},
+		{"sensors/temperature", true, ""},
+		{"$aws/things/my-thing/shadow/update", false, ""},
+		{"sensors/+/temperature", true, ""},
+		{"sensors/#", true, ""},
+		{"#", true, ""},
+		{"+/+", true, ""},
+		{"sensors/+/temperature", false, `must not contain the wildcard characters "#" or "+" in a topic used to publish`},
+		{"sensors/#", false, `must not contain the wildcard characters "#" or "+" in a topic used to publish`},
+		{"sensors/#/temperature", true, `must only contain the "#" wildcard as the entire last topic level`},
+		{"sensors/temp#", true, `must only contain the "#" wildcard as the entire last topic level`},
+		{"sensors/temp+", true, `must only contain the "+" wildcard as an entire topic level`},
+		{"", true, "cannot be shorter than 1 character"},
+		{strings.Repeat("a", 257), false, "is 257 bytes long, the maximum is 256 bytes"},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidIoTTopic(tc.Subscribe)(tc.Value, "topic")
+		if tc.ExpectedErrSubstr == "" {
+			if len(errors) != 0 {
+				t.Fatalf("%q (subscribe %t): expected no error, got %q", tc.Value, tc.Subscribe, errors)
+			}
+			continue
+		}
+		if len(errors) != 1 {
+			t.Fatalf("%q (subscribe %t): expected 1 error, got %d: %q", tc.Value, tc.Subscribe, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q (subscribe %t): expected error %q to include %q", tc.Value, tc.Subscribe, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidIAMPolicyConditionOperators(t *testing.T) {
+	t.Parallel()
+
+	validPolicies := []string{
+		"",
+		`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+		`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*",
+      "Condition": {
+        "StringEquals": {"aws:PrincipalTag/team": "a"},
+        "StringLikeIfExists": {"s3:prefix": "home/*"},
+        "IpAddress": {"aws:SourceIp": "203.0.113.0/24"},
+        "DateGreaterThan": {"aws:CurrentTime": "2020-01-01T00:00:00Z"},
+        "Bool": {"aws:SecureTransport": "true"},
+        "Null": {"aws:TokenIssueTime": "false"},
+        "ForAllValues:StringEquals": {"aws:TagKeys": ["a", "b"]},
+        "ForAnyValue:StringNotLikeIfExists": {"aws:TagKeys": "x*"}
+      }
+    }
+  ]
+}`,
+	}
+	for _, v := range validPolicies {
+		_, errors := ValidIAMPolicyConditionOperators(v, "policy")
+		if len(errors) != 0 {
+			t.Fatalf("%q should use only recognized condition operators: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{
+			`{"Statement": [{"Effect": "Allow", "Condition": {"StringEqualss": {"aws:PrincipalTag/team": "a"}}}]}`,
+			`"policy": statement 0: unknown condition operator "StringEqualss"; did you mean "StringEquals"?`,
+		},
+		{
+			`{"Statement": [{"Effect": "Allow"}, {"Effect": "Allow", "Condition": {"ForAllValues:StringEqual": {"aws:TagKeys": "a"}}}]}`,
+			`"policy": statement 1: unknown condition operator "ForAllValues:StringEqual"; did you mean "StringEquals"?`,
+		},
+		{
+			`{"Statement": {"Effect": "Allow", "Condition": {"Matches": {"aws:TagKeys": "a"}}}}`,
+			`"policy": statement 0: unknown condition operator "Matches"`,
+		},
+		{
+			`{"Statement": {"Effect": "Allow", "Condition": "StringEquals"}}`,
+			`"policy": statement 0: Condition must be an object`,
+		},
+		{
+			`{"Statement": [`,
+			`contains an invalid JSON policy`,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := ValidIAMPolicyConditionOperators(tc.Value, "policy")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidIAMPolicyUniqueSids(t *testing.T) {
+	t.Parallel()
+
+	validPolicies := []string{
+		"",
+		`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+		`{"Version": "2012-10-17", "Statement": {"Sid": "AllowGet", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}}`,
+		`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "AllowGet", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"},
+    {"Sid": "AllowPut", "Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"},
+    {"Effect": "Deny", "Action": "s3:DeleteObject", "Resource": "*"},
+    {"Effect": "Deny", "Action": "s3:DeleteBucket", "Resource": "*"},
+    {"Sid": "", "Effect": "Allow", "Action": "s3:ListBucket", "Resource": "*"},
+    {"Sid": "", "Effect": "Allow", "Action": "s3:ListAllMyBuckets", "Resource": "*"}
+  ]
+}`,
+	}
+	for _, v := range validPolicies {
+		_, errors := ValidIAMPolicyUniqueSids(v, "policy")
+		if len(errors) != 0 {
+			t.Fatalf("%q should have unique Sids: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{
+			`{"Statement": [{"Sid": "AllowGet", "Effect": "Allow"}, {"Sid": "AllowPut", "Effect": "Allow"}, {"Sid": "AllowGet", "Effect": "Deny"}]}`,
+			`"policy": statement 2: Sid "AllowGet" is already used by statement 0; Sids must be unique`,
+		},
+		{
+			`{"Statement": [{"Sid": 1, "Effect": "Allow"}]}`,
+			`"policy": statement 0: Sid must be a string`,
+		},
+		{
+			`{"Statement": [`,
+			`contains an invalid JSON policy`,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := ValidIAMPolicyUniqueSids(tc.Value, "policy")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+
+	_, errors := ValidIAMPolicyUniqueSids(`{"Statement": [{"Sid": "A"}, {"Sid": "A"}, {"Sid": "A"}]}`, "policy")
+	if len(errors) != 2 {
+		t.Fatalf("expected an error for each duplicate, got %d: %q", len(errors), errors)
+	}
+}
+
+func TestValidUUID(t *testing.T) {
+	t.Parallel()
+
+	validUUIDs := []string{
+		"1234abcd-12ab-34cd-56ef-1234567890ab",
+		"1234ABCD-12AB-34CD-56EF-1234567890AB",
+		"00000000-0000-0000-0000-000000000000",
+	}
+	for _, v := range validUUIDs {
+		_, errors := ValidUUID(v, "key_id")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid UUID: %q", v, errors)
+		}
+	}
+
+	invalidUUIDs := []string{
+		"",
+		"not-a-uuid",
+		"1234abcd12ab34cd56ef1234567890ab",
+		"{1234abcd-12ab-34cd-56ef-1234567890ab}",
+		"1234abcg-12ab-34cd-56ef-1234567890ab",
+		"1234abc-12ab-34cd-56ef-1234567890abc",
+		"1234abcd-12ab-34cd-56e-f1234567890ab",
+		"1234abcd-12ab-34cd-56ef-1234567890ab-",
+	}
+	for _, v := range invalidUUIDs {
+		_, errors := ValidUUID(v, "key_id")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid UUID", v)
+		}
+	}
+}
+
+func TestValidEMRClusterName(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"a",
+		"My Spark Cluster (prod) #1",
+		"クラスター",
+		strings.Repeat("a", 256),
+	}
+	for _, v := range validNames {
+		_, errors := ValidEMRClusterName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid EMR cluster name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		strings.Repeat("a", 257),
+		"my\ncluster",
+		"my\tcluster",
+		"my\x00cluster",
+	}
+	for _, v := range invalidNames {
+		_, errors := ValidEMRClusterName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid EMR cluster name", v)
+		}
+	}
+}
+
+func TestValidDNSName(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"example.com",
+		"example.com.",
+		"mail.example.co.uk",
+		"xn--bcher-kva.example",
+		"a-b.example.com",
+		strings.Repeat("a", 63) + ".com",
+	}
+	for _, v := range validNames {
+		_, errors := ValidDNSName(v, "domain")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DNS name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"localhost",
+		"example..com",
+		".example.com",
+		"-example.com",
+		"example-.com",
+		"exa_mple.com",
+		"example.com/path",
+		strings.Repeat("a", 64) + ".com",
+		strings.Repeat("a.", 127) + "com",
+	}
+	for _, v := range invalidNames {
+		_, errors := ValidDNSName(v, "domain")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DNS name", v)
+		}
+	}
+}
+
+func TestValidEmailAddress(t *testing.T) {
+	t.Parallel()
+
+	validAddresses := []string{
+		"user@example.com",
+		"first.last+tag@mail.example.co.uk",
+	}
+	for _, v := range validAddresses {
+		_, errors := ValidEmailAddress(v, "email")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid email address: %q", v, errors)
+		}
+	}
+
+	invalidAddresses := []string{
+		"",
+		"user",
+		"user@",
+		"@example.com",
+		"user@localhost",
+		"user@exa_mple.com",
+		"User <user@example.com>",
+		"user@@example.com",
+	}
+	for _, v := range invalidAddresses {
+		_, errors := ValidEmailAddress(v, "email")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid email address", v)
+		}
+	}
+}
+
+func TestValidSESIdentity(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"user@example.com", "example.com", "mail.example.com"} {
+		_, errors := ValidSESIdentity(v, "identity")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid SES identity: %q", v, errors)
+		}
+	}
+
+	for _, v := range []string{"", "example", "user@example", "user@exa_mple.com", "not an identity"} {
+		_, errors := ValidSESIdentity(v, "identity")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", v, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), "must be either an email address (e.g. user@example.com) or a domain name (e.g. example.com)") {
+			t.Fatalf("%q: unexpected error %q", v, errors[0])
+		}
+	}
+}
+
+func TestValidLoadBalancerName(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{
+		"a",
+		"my-load-balancer",
+		"MyALB01",
+		"internal",
+		"my-internal-lb",
+		strings.Repeat("a", 32),
+	} {
+		_, errors := ValidLoadBalancerName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid load balancer name: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"", `"name" cannot be shorter than 1 character`},
+		{strings.Repeat("a", 33), `"name" cannot be longer than 32 characters`},
+		{"my_lb", `only alphanumeric characters and hyphens allowed in "name"`},
+		{"-my-lb", `"name" (-my-lb) cannot begin or end with a hyphen`},
+		{"my-lb-", `"name" (my-lb-) cannot begin or end with a hyphen`},
+		{"internal-lb", `"name" (internal-lb) cannot begin with "internal-"`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidLoadBalancerName(tc.Value, "name")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidTransferHomeDirectory(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"/", "/bucket", "/bucket/home/user", "/" + strings.Repeat("a", 1023)} {
+		_, errors := ValidTransferHomeDirectory(v, "home_directory")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Transfer home directory: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"", `"home_directory" must be an absolute path beginning with "/", got ""`},
+		{"bucket/home", `"home_directory" must be an absolute path beginning with "/", got "bucket/home"`},
+		{"/" + strings.Repeat("a", 1024), `"home_directory" cannot be longer than 1024 characters`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidTransferHomeDirectory(tc.Value, "home_directory")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidTransferHomeDirectoryMappings(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{
+		`[]`,
+		`[{"Entry": "/", "Target": "/bucket/home/user"}]`,
+		`[{"Entry": "/data", "Target": "/bucket/data", "Type": "DIRECTORY"}, {"Entry": "/readme.txt", "Target": "/bucket/readme.txt", "Type": "FILE"}]`,
+	} {
+		_, errors := ValidTransferHomeDirectoryMappings(v, "home_directory_mappings")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid Transfer home directory mappings: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{`{"Entry": "/", "Target": "/bucket"}`, `"home_directory_mappings" must be a JSON array of mapping objects`},
+		{`[{"Entry": "/", "Target": "/bucket"}, {"Target": "/bucket"}]`, `"home_directory_mappings": mapping 1: Entry is required`},
+		{`[{"Entry": "/", "Target": "bucket/home"}]`, `"home_directory_mappings": mapping 0: Target must be an absolute path beginning with "/", got "bucket/home"`},
+		{`[{"Entry": "/", "Target": "/bucket", "Type": "LINK"}]`, `"home_directory_mappings": mapping 0: Type must be one of ["FILE" "DIRECTORY"], got LINK`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidTransferHomeDirectoryMappings(tc.Value, "home_directory_mappings")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidHexColor(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"#FFAA00", "#ffaa00", "#FfAa00", "#FA0", "#fa0", "#000000"} {
+		_, errors := ValidHexColor(v, "color")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid hex color: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"FFAA00", `"color" (FFAA00) must begin with "#"`},
+		{"", `"color" () must begin with "#"`},
+		{"#FFAA", `"color" (#FFAA) must have 3 or 6 hex digits, got 4`},
+		{"#FFAA0000", `"color" (#FFAA0000) must have 3 or 6 hex digits, got 8`},
+		{"#GGAA00", `"color" (#GGAA00) can only contain hex digits`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidHexColor(tc.Value, "color")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestIntInRangeOrValue(t *testing.T) {
+	t.Parallel()
+
+	f := IntInRangeOrValue(1, 100, -1)
+
+	for _, v := range []int{1, 50, 100, -1} {
+		_, errors := f(v, "max_connections")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be in range or the sentinel: %q", v, errors)
+		}
+	}
+
+	for _, v := range []int{0, 101, -2} {
+		_, errors := f(v, "max_connections")
+		if len(errors) != 1 {
+			t.Fatalf("%d: expected 1 error, got %d: %q", v, len(errors), errors)
+		}
+		if expected := fmt.Sprintf("expected max_connections to be in the range (1 - 100), or -1 to indicate no limit, got %d", v); errors[0].Error() != expected {
+			t.Fatalf("%d: expected error %q, got %q", v, expected, errors[0])
+		}
+	}
+
+	if _, errors := f("1", "max_connections"); len(errors) != 1 {
+		t.Fatalf("expected 1 error for non-integer value, got %d: %q", len(errors), errors)
+	}
+}
+
+func TestValidGraphQLSchema(t *testing.T) {
+	t.Parallel()
+
+	validSchema := `schema {
+  query: Query
+  mutation: Mutation
+}
+
+# A post. Comments may contain unbalanced brackets: {
+type Post {
+  id: ID!
+  """
+  The title (may contain "quotes" and brackets: ]).
+  """
+  title: String @deprecated(reason: "use \"name\" }")
+  tags: [String!]!
+}
+
+type Query {
+  getPost(id: ID!): Post
+  listPosts(limit: Int = 10): [Post]
+}
+
+type Mutation {
+  addPost(title: String!): Post
+}
+`
+
+	for _, v := range []string{validSchema, "type Query { ping: String }"} {
+		_, errors := ValidGraphQLSchema(v, "schema")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid GraphQL schema: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"type Query {\n  ping: String\n", `unclosed '{' opened on line 1`},
+		{"type Query {\n  ping: String\n}\n}", `unexpected '}' on line 4`},
+		{"type Query {\n  posts(limit: Int]: [Post]\n}", `unexpected ']' on line 2`},
+		{"type Query {\n  ping(s: String = \"x): String\n}", `unterminated string`},
+		{"type Query {\n  \"\"\"\n  ping: String\n}", `unterminated block string`},
+		{"scalar AWSDateTime", `no type definitions found`},
+		{"# type Query { ping: String }", `no type definitions found`},
+		{"", `no type definitions found`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidGraphQLSchema(tc.Value, "schema")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidCloudFrontPathPattern(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"*", "/images/*", "*.jpg", "/api/v1/users", "/images/photo?.jpg", "/" + strings.Repeat("a", 254)} {
+		_, errors := ValidCloudFrontPathPattern(v, "path_pattern")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CloudFront path pattern: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"", `"path_pattern" cannot be shorter than 1 character`},
+		{"/" + strings.Repeat("a", 255), `"path_pattern" cannot be longer than 255 characters`},
+		{"/a/*/b", `"path_pattern" (/a/*/b) can only contain a "*" wildcard at the start or end of the pattern`},
+		{"*/images/*", `"path_pattern" (*/images/*) can contain at most one "*" wildcard, got 2`},
+		{"/images/my photo.jpg", `"path_pattern" (/images/my photo.jpg) can only contain letters, numbers and the characters`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidCloudFrontPathPattern(tc.Value, "path_pattern")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidOpenSearchDomainName(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"abc", "my-domain", "logs-2024", strings.Repeat("a", 28)} {
+		_, errors := ValidOpenSearchDomainName(v, "domain_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid OpenSearch domain name: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"ab", `"domain_name" (ab) cannot be shorter than 3 characters`},
+		{strings.Repeat("a", 29), `cannot be longer than 28 characters`},
+		{"My-Domain", `"domain_name" (My-Domain) cannot contain uppercase characters`},
+		{"my_domain", `only lowercase alphanumeric characters and hyphens allowed in "domain_name" (my_domain)`},
+		{"1-domain", `first character of "domain_name" (1-domain) must be a letter`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidOpenSearchDomainName(tc.Value, "domain_name")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidTimeZone(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"UTC", "America/New_York", "Europe/London", "Asia/Kolkata", "America/Argentina/Buenos_Aires"} {
+		ws, errors := ValidTimeZone(v, "time_zone")
+		if len(errors) != 0 || len(ws) != 0 {
+			t.Fatalf("%q should be a valid time zone without warnings: %q %q", v, ws, errors)
+		}
+	}
+
+	for _, v := range []string{"EST", "Japan"} {
+		ws, errors := ValidTimeZone(v, "time_zone")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid time zone: %q", v, errors)
+		}
+		if len(ws) != 1 || !strings.Contains(ws[0], "is a deprecated time zone name") {
+			t.Fatalf("%q: expected a deprecation warning, got %q", v, ws)
+		}
+	}
+
+	for _, v := range []string{"", "Local", "America/Springfield", "Mars/Olympus_Mons", "+05:00"} {
+		_, errors := ValidTimeZone(v, "time_zone")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", v, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), `must be an IANA time zone name, e.g. "America/New_York"`) {
+			t.Fatalf("%q: unexpected error %q", v, errors[0])
+		}
+	}
+}
+
+func TestValidLogRetentionDays(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []int{0, 1, 30, 365, 3653} {
+		_, errors := ValidLogRetentionDays(v, "retention_in_days")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid log retention period: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             int
+		ExpectedErrSubstr string
+	}{
+		{45, "got 45; did you mean 60?"},
+		{40, "got 40; did you mean 30?"},
+		{2, "got 2; did you mean 3?"},
+		{-1, "got -1; did you mean 1?"},
+		{366, "got 366; did you mean 365?"},
+		{10000, "got 10000; did you mean 3653?"},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidLogRetentionDays(tc.Value, "retention_in_days")
+		if len(errors) != 1 {
+			t.Fatalf("%d: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%d: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidGrowthFactor(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []float64{1.0, 10.5, 50, 100.0} {
+		_, errors := ValidGrowthFactor(v, "growth_factor")
+		if len(errors) != 0 {
+			t.Fatalf("%g should be a valid growth factor: %q", v, errors)
+		}
+	}
+
+	for _, v := range []interface{}{0.0, 0.99, 100.01, -1.0, 10, "10"} {
+		_, errors := ValidGrowthFactor(v, "growth_factor")
+		if len(errors) == 0 {
+			t.Fatalf("%v should be an invalid growth factor", v)
+		}
+	}
+
+	_, errors := ValidGrowthFactor(100.5, "growth_factor")
+	if !strings.Contains(errors[0].Error(), `"growth_factor" (100.5) must be between 1.0 and 100.0`) {
+		t.Fatalf("expected range error, got %q", errors[0])
+	}
+}
+
+func TestValidDeploymentDurationMinutes(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []int{0, 1, 60, 1439, 1440} {
+		_, errors := ValidDeploymentDurationMinutes(v, "deployment_duration_in_minutes")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid deployment duration: %q", v, errors)
+		}
+	}
+
+	for _, v := range []interface{}{-1, 1441, 10080, 60.0, "60"} {
+		_, errors := ValidDeploymentDurationMinutes(v, "deployment_duration_in_minutes")
+		if len(errors) == 0 {
+			t.Fatalf("%v should be an invalid deployment duration", v)
+		}
+	}
+
+	_, errors := ValidDeploymentDurationMinutes(1441, "deployment_duration_in_minutes")
+	if !strings.Contains(errors[0].Error(), `"deployment_duration_in_minutes" (1441) must be between 0 and 1440 minutes`) {
+		t.Fatalf("expected range error, got %q", errors[0])
+	}
+}
+
+func TestValidWAFCapacity(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []int{0, 1, 1500, 4999, 5000} {
+		_, errors := ValidWAFCapacity(v, "capacity")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid WAF capacity: %q", v, errors)
+		}
+	}
+
+	for _, v := range []interface{}{-1, 5001, 10000, "1500"} {
+		_, errors := ValidWAFCapacity(v, "capacity")
+		if len(errors) == 0 {
+			t.Fatalf("%v should be an invalid WAF capacity", v)
+		}
+	}
+
+	_, errors := ValidWAFCapacity(5001, "capacity")
+	if !strings.Contains(errors[0].Error(), `"capacity" (5001) must be between 0 and 5000 WCUs`) {
+		t.Fatalf("expected range error, got %q", errors[0])
+	}
+}
+
+func TestValidBeanstalkApplicationName(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"a", "My Application", "my-app_v2.0", strings.Repeat("a", 100), strings.Repeat("é", 100)} {
+		_, errors := ValidBeanstalkApplicationName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Elastic Beanstalk application name: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"", `"name" cannot be shorter than 1 character`},
+		{strings.Repeat("a", 101), `"name" cannot be longer than 100 characters`},
+		{"my/app", `"name" (my/app) cannot contain a forward slash (/)`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidBeanstalkApplicationName(tc.Value, "name")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidBeanstalkEnvironmentName(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"prod", "my-env-01", "MyEnvironment", strings.Repeat("a", 40)} {
+		_, errors := ValidBeanstalkEnvironmentName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Elastic Beanstalk environment name: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"dev", `"name" (dev) cannot be shorter than 4 characters`},
+		{strings.Repeat("a", 41), `cannot be longer than 40 characters`},
+		{"my_env", `only alphanumeric characters and hyphens allowed in "name" (my_env)`},
+		{"-my-env", `"name" (-my-env) cannot begin or end with a hyphen`},
+		{"my-env-", `"name" (my-env-) cannot begin or end with a hyphen`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidBeanstalkEnvironmentName(tc.Value, "name")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidCloudTrailKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{"", "cloudtrail", "logs/cloudtrail/", "my-prefix_01.(prod)!*'", strings.Repeat("a", 200)} {
+		_, errors := ValidCloudTrailKeyPrefix(v, "s3_key_prefix")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CloudTrail key prefix: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"/cloudtrail", `"s3_key_prefix" (/cloudtrail) cannot begin with a slash`},
+		{strings.Repeat("a", 201), `"s3_key_prefix" cannot be longer than 200 characters, got 201`},
+		{"cloud trail", `"s3_key_prefix" (cloud trail) contains invalid character ' ' at offset 5`},
+		{"logs/año", `contains invalid character 'ñ' at offset 6`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidCloudTrailKeyPrefix(tc.Value, "s3_key_prefix")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
+
+func TestValidDXVLAN(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []int{1, 100, 4094} {
+		_, errors := ValidDXVLAN(v, "vlan")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid Direct Connect VLAN ID: %q", v, errors)
+		}
+	}
+
+	for _, v := range []interface{}{0, 4095, -1, 5000, "100"} {
+		_, errors := ValidDXVLAN(v, "vlan")
+		if len(errors) == 0 {
+			t.Fatalf("%v should be an invalid Direct Connect VLAN ID", v)
+		}
+	}
+
+	_, errors := ValidDXVLAN(4095, "vlan")
+	if !strings.Contains(errors[0].Error(), `"vlan" (4095) must be between 1 and 4094; VLAN IDs 0 and 4095 are reserved`) {
+		t.Fatalf("expected range error, got %q", errors[0])
+	}
+}
+
+func TestValidJMESPath(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []string{
+		"foo",
+		"foo.bar[0]",
+		"Items[?Status=='ACTIVE'].Name",
+		"length(people[?age > `20`])",
+		"reservations[*].instances[*].{id: InstanceId, state: State.Name}",
+	} {
+		_, errors := ValidJMESPath(v, "expression")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid JMESPath expression: %q", v, errors)
+		}
+	}
+
+	cases := []struct {
+		Value             string
+		ExpectedErrSubstr string
+	}{
+		{"foo[0", `"expression" (foo[0) is not a valid JMESPath expression: Expected tRbracket, received: tEOF at offset 5`},
+		{"foo.", `"expression" (foo.) is not a valid JMESPath expression: Expected identifier, lbracket, or lbrace at offset 4`},
+		{"", `"expression" () is not a valid JMESPath expression: Incomplete expression at offset 0`},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidJMESPath(tc.Value, "expression")
+		if len(errors) != 1 {
+			t.Fatalf("%q: expected 1 error, got %d: %q", tc.Value, len(errors), errors)
+		}
+		if !strings.Contains(errors[0].Error(), tc.ExpectedErrSubstr) {
+			t.Fatalf("%q: expected error %q to include %q", tc.Value, errors[0], tc.ExpectedErrSubstr)
+		}
+	}
+}
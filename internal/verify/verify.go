@@ -4,6 +4,9 @@
 package verify
 
 import (
+	"strings"
+	"unicode/utf8"
+
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"gopkg.in/yaml.v2"
@@ -21,6 +24,62 @@ func SliceContainsString(slice []interface{}, s string) (int, bool) {
 	return -1, false
 }
 
+// closestMatch returns the candidate closest to the specified string, ignoring case, and
+// whether it is close enough to be offered as a suggestion.
+func closestMatch(s string, candidates []string) (string, bool) {
+	if s == "" {
+		return "", false
+	}
+
+	var match string
+	best := -1
+
+	for _, candidate := range candidates {
+		if d := levenshteinDistance(strings.ToLower(s), strings.ToLower(candidate)); best == -1 || d < best {
+			match, best = candidate, d
+		}
+	}
+
+	// Allow roughly one edit for every three characters.
+	threshold := utf8.RuneCountInString(s) / 3
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	return match, best != -1 && best <= threshold
+}
+
+// levenshteinDistance returns the minimum number of single-character edits needed to change one string into the other.
+func levenshteinDistance(s1, s2 string) int {
+	r1, r2 := []rune(s1), []rune(s2)
+	prev := make([]int, len(r2)+1)
+	curr := make([]int, len(r2)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(r1); i++ {
+		curr[0] = i
+		for j := 1; j <= len(r2); j++ {
+			cost := 1
+			if r1[i-1] == r2[j-1] {
+				cost = 0
+			}
+			curr[j] = prev[j] + 1
+			if v := curr[j-1] + 1; v < curr[j] {
+				curr[j] = v
+			}
+			if v := prev[j-1] + cost; v < curr[j] {
+				curr[j] = v
+			}
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(r2)]
+}
+
 // Takes a value containing YAML string and passes it through
 // the YAML parser. Returns either a parsing
 // error or original YAML string.
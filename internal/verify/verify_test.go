@@ -44,3 +44,82 @@ abc:
 		t.Fatalf("Got:\n\n%s\n\nExpected:\n\n%s\n", actual, invalidYaml)
 	}
 }
+
+func TestClosestMatch(t *testing.T) {
+	t.Parallel()
+
+	candidates := []string{"allow-all", "https-only", "redirect-to-https"}
+
+	for _, tc := range []struct {
+		value         string
+		expectedMatch string
+		expectedOK    bool
+	}{
+		{"allow-all", "allow-all", true},
+		{"ALLOW-ALL", "allow-all", true},
+		{"alow-all", "allow-all", true},
+		{"https_only", "https-only", true},
+		{"redirect-to-http", "redirect-to-https", true},
+		{"something-else", "", false},
+		{"", "", false},
+	} {
+		match, ok := closestMatch(tc.value, candidates)
+		if ok != tc.expectedOK {
+			t.Errorf("closestMatch(%q): expected ok %t, got %t (%q)", tc.value, tc.expectedOK, ok, match)
+		}
+		if ok && match != tc.expectedMatch {
+			t.Errorf("closestMatch(%q): expected %q, got %q", tc.value, tc.expectedMatch, match)
+		}
+	}
+
+	if _, ok := closestMatch("anything", nil); ok {
+		t.Errorf("closestMatch with no candidates: expected no match")
+	}
+
+	// The threshold is based on the number of characters, not bytes.
+	for _, tc := range []struct {
+		value         string
+		candidates    []string
+		expectedMatch string
+		expectedOK    bool
+	}{
+		{"café", []string{"cafe", "tea"}, "cafe", true},
+		{"ÉTÉ", []string{"été"}, "été", true},
+		{"ééé", []string{"éxx"}, "", false},
+		{"日本語", []string{"日本語"}, "日本語", true},
+	} {
+		match, ok := closestMatch(tc.value, tc.candidates)
+		if ok != tc.expectedOK {
+			t.Errorf("closestMatch(%q): expected ok %t, got %t (%q)", tc.value, tc.expectedOK, ok, match)
+		}
+		if ok && match != tc.expectedMatch {
+			t.Errorf("closestMatch(%q): expected %q, got %q", tc.value, tc.expectedMatch, match)
+		}
+	}
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		s1, s2   string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"CNAME", "CNAME", 0},
+		{"CNAM", "CNAME", 1},
+		{"flaw", "lawn", 2},
+		{"café", "café", 0},
+		{"café", "cafe", 1},
+		{"日本語", "日本", 1},
+		{"🙂", "🙃", 1},
+		{"", "日本語", 3},
+	} {
+		if got := levenshteinDistance(tc.s1, tc.s2); got != tc.expected {
+			t.Errorf("levenshteinDistance(%q, %q): expected %d, got %d", tc.s1, tc.s2, tc.expected, got)
+		}
+	}
+}